@@ -3,19 +3,25 @@
 package auth
 
 import (
+	"bufio"
 	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/pkg/browser"
 	"github.com/scttfrdmn/globus-go-sdk/pkg"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/scopes"
+	sdkauth "github.com/scttfrdmn/globus-go-sdk/pkg/services/auth"
 )
 
 // Config holds the CLI configuration
@@ -45,6 +51,9 @@ const (
 
 	// DefaultRedirectURI is the redirect URI for browser-based auth
 	DefaultRedirectURI = "http://localhost:8080/callback"
+
+	// DefaultCallbackPort is the port of the local callback server
+	DefaultCallbackPort = 8080
 )
 
 // IsTokenValid checks if a token is still valid (not expired)
@@ -179,26 +188,157 @@ func getConfigDir() (string, error) {
 	return configDir, nil
 }
 
+// loginServices are the services whose scopes login requests by default
+var loginServices = []string{"auth", "transfer", "groups", "search", "flows", "compute", "timers"}
+
+// loginExtraScopes are requested by default along with the service scopes.
+// The identity set is needed by whoami --linked-identities and the session
+// commands.
+var loginExtraScopes = []string{scopes.AuthViewIdentitySet}
+
+// stringListFlag collects a flag that may be repeated or given as a
+// comma-separated list
+type stringListFlag []string
+
+// String implements flag.Value
+func (f *stringListFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+// Set implements flag.Value
+func (f *stringListFlag) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*f = append(*f, item)
+		}
+	}
+	return nil
+}
+
+// loginOptions holds the flags of the login command
+type loginOptions struct {
+	noLocalServer bool
+	noBrowser     bool
+	port          int
+	force         bool
+	scopes        []string
+}
+
+// addBrowserFlags registers the flags that choose how the user reaches the
+// login page, shared by login and session update
+func addBrowserFlags(flags *flag.FlagSet, options *loginOptions) {
+	flags.BoolVar(&options.noLocalServer, "no-local-server", false,
+		"print the login URL and read the authorization code from the terminal instead of running a local callback server")
+	flags.BoolVar(&options.noBrowser, "no-browser", false,
+		"print the login URL instead of opening a browser, for callbacks forwarded over SSH")
+	flags.IntVar(&options.port, "port", DefaultCallbackPort, "port of the local callback server")
+}
+
+// parseLoginFlags parses the flags of the login command
+func parseLoginFlags(args []string) (*loginOptions, error) {
+	options := &loginOptions{}
+	var scopes, services stringListFlag
+
+	flags := flag.NewFlagSet("login", flag.ContinueOnError)
+	addBrowserFlags(flags, options)
+	flags.BoolVar(&options.force, "force", false, "log in again even if the saved tokens cover the requested scopes")
+	flags.Var(&scopes, "scope", "scope to request (repeatable)")
+	flags.Var(&services, "service", "service to request the scope of: "+strings.Join(loginServices, ", ")+" (repeatable)")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+
+	for _, service := range services {
+		serviceScopes := pkg.GetScopesByService(service)
+		if len(serviceScopes) == 0 {
+			return nil, fmt.Errorf("unknown service %q, want one of %s", service, strings.Join(loginServices, ", "))
+		}
+		scopes = append(scopes, serviceScopes...)
+	}
+
+	// Without flags, request every service so the login is useful for all commands
+	if len(scopes) == 0 {
+		scopes = append(pkg.GetScopesByService(loginServices...), loginExtraScopes...)
+	}
+	options.scopes = mergeScopes(nil, scopes...)
+
+	return options, nil
+}
+
+// mergeScopes adds the scopes in the space-separated scope strings to scopes,
+// skipping duplicates
+func mergeScopes(scopes []string, scopeStrings ...string) []string {
+	for _, scopeString := range scopeStrings {
+		for _, scope := range strings.Fields(scopeString) {
+			if !containsScope(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes
+}
+
+// containsScope reports whether scopes contains scope
+func containsScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
 // LoginCommand handles the login command
 func LoginCommand(args []string) error {
+	options, err := parseLoginFlags(args)
+	if err != nil {
+		return err
+	}
+
 	// Load the configuration
 	config, err := LoadOrCreateConfig()
 	if err != nil {
 		return fmt.Errorf("error loading configuration: %w", err)
 	}
 
-	// Start the local server
-	server, err := startLocalServer()
+	// Request the scopes that were already granted as well, so that
+	// consenting to more scopes keeps the existing tokens usable
+	granted, err := grantedScopes(config)
 	if err != nil {
-		return fmt.Errorf("error starting local server: %w", err)
+		return fmt.Errorf("error reading saved tokens: %w", err)
+	}
+	missing := false
+	for _, scope := range options.scopes {
+		if !containsScope(granted, scope) {
+			missing = true
+			break
+		}
 	}
+	if !missing && !options.force {
+		fmt.Println("Already logged in with the requested scopes. Use --force to log in again.")
+		return nil
+	}
+	return runLogin(config, options, sdkauth.AuthorizationParameters{}, mergeScopes(granted, options.scopes...))
+}
 
+// runLogin sends the user through a login requesting scopes and meeting the
+// session requirements in params, then saves the resulting tokens
+func runLogin(config *Config, options *loginOptions, params sdkauth.AuthorizationParameters, scopes []string) error {
 	// Generate a random state value
 	state, err := generateRandomState()
 	if err != nil {
 		return fmt.Errorf("error generating state: %w", err)
 	}
 
+	// Native apps prove the code exchange with PKCE instead of a secret
+	pkce, err := sdkauth.NewPKCE()
+	if err != nil {
+		return fmt.Errorf("error generating PKCE challenge: %w", err)
+	}
+
 	// Generate the authorization URL
 	sdkConfig := pkg.NewConfig().
 		WithClientID(config.ClientID).
@@ -209,57 +349,94 @@ func LoginCommand(args []string) error {
 		return fmt.Errorf("error creating auth client: %w", err)
 	}
 
-	// Use all scopes to make the login useful for all commands
-	scopes := []string{
-		pkg.AuthScope,
-		pkg.TransferScope,
-		pkg.GroupsScope,
-		pkg.SearchScope,
-		pkg.FlowsScope,
-		pkg.ComputeScope,
-		pkg.TimersScope,
-	}
-
 	// Set the redirect URL
-	authClient.SetRedirectURL(DefaultRedirectURI)
+	redirectURI := fmt.Sprintf("http://localhost:%d/callback", options.port)
+	if options.noLocalServer {
+		redirectURI = sdkauth.NativeAppRedirectURL
+	}
+	authClient.SetRedirectURL(redirectURI)
 
 	// Get the URL for the login
-	authURL := authClient.GetAuthorizationURL(state, scopes...)
+	authURL := authClient.GetNativeAppAuthorizationURL(state, pkce, params, scopes...)
+
+	var code string
+	if options.noLocalServer {
+		code, err = readPastedCode(os.Stdin, authURL)
+	} else {
+		code, err = waitForCallback(options, authURL, state)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Exchange code for tokens
+	tokenResp, err := authClient.ExchangeAuthorizationCodeWithPKCE(context.Background(), code, pkce.Verifier)
+	if err != nil {
+		return fmt.Errorf("error exchanging code for token: %w", err)
+	}
+
+	// Save the tokens
+	if err := saveTokenResponse(config, tokenResp); err != nil {
+		return fmt.Errorf("error saving token: %w", err)
+	}
+
+	fmt.Println("Login successful!")
+	return nil
+}
 
-	// Open the browser
-	fmt.Printf("Opening browser to login at: %s\n", authURL)
-	if err := browser.OpenURL(authURL); err != nil {
-		fmt.Printf("Failed to open browser automatically. Please open this URL in your browser:\n%s\n", authURL)
+// waitForCallback sends the user to authURL and waits for the local callback
+// server to receive the authorization code
+func waitForCallback(options *loginOptions, authURL, state string) (string, error) {
+	// Start the local server
+	server, err := startLocalServer(options.port)
+	if err != nil {
+		return "", fmt.Errorf("error starting local server: %w", err)
+	}
+	defer server.Server.Close()
+
+	if options.noBrowser {
+		fmt.Printf("Please open this URL in a browser that can reach port %d on this machine:\n%s\n", options.port, authURL)
+	} else {
+		// Open the browser
+		fmt.Printf("Opening browser to login at: %s\n", authURL)
+		if err := browser.OpenURL(authURL); err != nil {
+			fmt.Printf("Failed to open browser automatically. Please open this URL in your browser:\n%s\n", authURL)
+		}
 	}
 
 	// Wait for the callback
 	select {
 	case result := <-server.ResultChan:
 		if result.Error != nil {
-			return fmt.Errorf("error during login: %w", result.Error)
+			return "", fmt.Errorf("error during login: %w", result.Error)
 		}
 
 		// Check state value
 		if result.State != state {
-			return fmt.Errorf("state mismatch, possible CSRF attack")
+			return "", fmt.Errorf("state mismatch, possible CSRF attack")
 		}
 
-		// Exchange code for token
-		token, err := exchangeCodeForToken(config, result.Code)
-		if err != nil {
-			return fmt.Errorf("error exchanging code for token: %w", err)
-		}
+		return result.Code, nil
+	case <-time.After(5 * time.Minute):
+		return "", fmt.Errorf("login timed out after 5 minutes")
+	}
+}
 
-		// Save the token
-		if err := saveToken(config, DefaultTokenFile, token); err != nil {
-			return fmt.Errorf("error saving token: %w", err)
-		}
+// readPastedCode prints authURL and reads the authorization code that Globus
+// Auth shows after login from in
+func readPastedCode(in io.Reader, authURL string) (string, error) {
+	fmt.Printf("Please open this URL in a browser and log in:\n\n%s\n\n", authURL)
+	fmt.Print("Enter the resulting authorization code: ")
 
-		fmt.Println("Login successful!")
-		return nil
-	case <-time.After(5 * time.Minute):
-		return fmt.Errorf("login timed out after 5 minutes")
+	line, err := bufio.NewReader(in).ReadString('\n')
+	code := strings.TrimSpace(line)
+	if err != nil && !(errors.Is(err, io.EOF) && code != "") {
+		return "", fmt.Errorf("error reading authorization code: %w", err)
+	}
+	if code == "" {
+		return "", fmt.Errorf("no authorization code entered")
 	}
+	return code, nil
 }
 
 // CallbackResult holds the result of the OAuth callback
@@ -275,8 +452,9 @@ type CallbackServer struct {
 	ResultChan chan CallbackResult
 }
 
-// startLocalServer starts a local HTTP server to receive the OAuth callback
-func startLocalServer() (*CallbackServer, error) {
+// startLocalServer starts a local HTTP server on port to receive the OAuth
+// callback
+func startLocalServer(port int) (*CallbackServer, error) {
 	resultChan := make(chan CallbackResult, 1)
 
 	mux := http.NewServeMux()
@@ -302,7 +480,7 @@ func startLocalServer() (*CallbackServer, error) {
 	})
 
 	server := &http.Server{
-		Addr:    ":8080",
+		Addr:    fmt.Sprintf(":%d", port),
 		Handler: mux,
 	}
 
@@ -328,38 +506,76 @@ func generateRandomState() (string, error) {
 	return base64.URLEncoding.EncodeToString(buffer), nil
 }
 
-// exchangeCodeForToken exchanges an authorization code for a token
-func exchangeCodeForToken(config *Config, code string) (*TokenInfo, error) {
-	// Create SDK configuration
-	sdkConfig := pkg.NewConfig().
-		WithClientID(config.ClientID).
-		WithClientSecret(config.ClientSecret)
-
-	authClient, err := sdkConfig.NewAuthClient()
+// saveTokenResponse saves the token for each resource server in a token
+// response. Tokens saved for other resource servers are kept. The primary
+// token is also saved as DefaultTokenFile.
+func saveTokenResponse(config *Config, tokenResp *sdkauth.TokenResponse) error {
+	otherTokens, err := tokenResp.GetOtherTokens()
 	if err != nil {
-		return nil, fmt.Errorf("error creating auth client: %w", err)
+		return err
+	}
+
+	for i, resp := range append([]*sdkauth.TokenResponse{tokenResp}, otherTokens...) {
+		token := &TokenInfo{
+			AccessToken:  resp.AccessToken,
+			RefreshToken: resp.RefreshToken,
+			ExpiresIn:    resp.ExpiresIn,
+			ExpiresAt:    time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second),
+			Scope:        resp.Scope,
+			TokenType:    resp.TokenType,
+			ResourceID:   resp.ResourceServer,
+		}
+		if i == 0 {
+			if err := saveToken(config, DefaultTokenFile, token); err != nil {
+				return err
+			}
+		}
+		if token.ResourceID != "" {
+			if err := saveToken(config, resourceTokenName(token.ResourceID), token); err != nil {
+				return err
+			}
+		}
 	}
 
-	// Set redirect URL for authorization code exchange
-	authClient.SetRedirectURL(DefaultRedirectURI)
+	return nil
+}
+
+// resourceTokenName returns the token file name for a resource server
+func resourceTokenName(resourceServer string) string {
+	return DefaultTokenFile + "-" + strings.ReplaceAll(resourceServer, string(filepath.Separator), "_")
+}
+
+// savedTokenFiles returns the paths of the saved resource server token files
+func savedTokenFiles(config *Config) ([]string, error) {
+	return filepath.Glob(filepath.Join(config.TokensDir, DefaultTokenFile+"-*.json"))
+}
 
-	// Exchange code for token
-	tokenResp, err := authClient.ExchangeAuthorizationCode(context.Background(), code)
+// grantedScopes returns the scopes of every saved token
+func grantedScopes(config *Config) ([]string, error) {
+	files, err := savedTokenFiles(config)
 	if err != nil {
-		return nil, fmt.Errorf("error exchanging authorization code: %w", err)
+		return nil, err
 	}
+	files = append(files, filepath.Join(config.TokensDir, DefaultTokenFile+".json"))
 
-	// Convert to token info
-	token := &TokenInfo{
-		AccessToken:  tokenResp.AccessToken,
-		RefreshToken: tokenResp.RefreshToken,
-		ExpiresIn:    tokenResp.ExpiresIn,
-		ExpiresAt:    time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second),
-		Scope:        tokenResp.Scope,
-		TokenType:    tokenResp.TokenType,
+	var scopes []string
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var token TokenInfo
+		if err := json.Unmarshal(data, &token); err != nil {
+			return nil, fmt.Errorf("failed to parse token file %s: %w", file, err)
+		}
+		scopes = mergeScopes(scopes, token.Scope)
 	}
 
-	return token, nil
+	return scopes, nil
 }
 
 // refreshToken refreshes a token
@@ -431,6 +647,28 @@ func LogoutCommand(args []string) error {
 		}
 	}
 
+	// Revoke and delete the tokens of the other resource servers
+	files, err := savedTokenFiles(config)
+	if err != nil {
+		return fmt.Errorf("error listing token files: %w", err)
+	}
+	for _, file := range files {
+		var resourceToken TokenInfo
+		if data, err := os.ReadFile(file); err == nil && json.Unmarshal(data, &resourceToken) == nil {
+			for _, t := range []string{resourceToken.AccessToken, resourceToken.RefreshToken} {
+				if t == "" || t == token.AccessToken || t == token.RefreshToken {
+					continue
+				}
+				if err := authClient.RevokeToken(context.Background(), t); err != nil {
+					fmt.Printf("Warning: Failed to revoke %s token: %v\n", resourceToken.ResourceID, err)
+				}
+			}
+		}
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error deleting token file: %w", err)
+		}
+	}
+
 	// Delete the token file
 	tokenFile := filepath.Join(config.TokensDir, DefaultTokenFile+".json")
 	if err := os.Remove(tokenFile); err != nil && !errors.Is(err, os.ErrNotExist) {
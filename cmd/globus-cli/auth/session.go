@@ -0,0 +1,369 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/authorizers"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/scopes"
+	sdkauth "github.com/scttfrdmn/globus-go-sdk/pkg/services/auth"
+)
+
+// Output formats accepted by --format
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// whoamiOutput is the JSON output of the whoami command
+type whoamiOutput struct {
+	UserInfo   *sdkauth.UserInfo  `json:"userinfo"`
+	Identities []sdkauth.Identity `json:"linked_identities,omitempty"`
+}
+
+// sessionIdentity is an identity linked to the user and, if the user has
+// logged in with it during the session, the details of that login
+type sessionIdentity struct {
+	sdkauth.Identity
+	AuthTime *time.Time `json:"auth_time,omitempty"`
+}
+
+// sessionOutput is the JSON output of the session show command
+type sessionOutput struct {
+	Identities []sessionIdentity `json:"identities"`
+}
+
+// addFormatFlag registers the --format flag
+func addFormatFlag(flags *flag.FlagSet) *string {
+	return flags.String("format", FormatText, "output format: text or json")
+}
+
+// checkFormat validates the value of --format
+func checkFormat(format string) error {
+	if format != FormatText && format != FormatJSON {
+		return fmt.Errorf("unknown format %q, want %s or %s", format, FormatText, FormatJSON)
+	}
+	return nil
+}
+
+// parseInterleaved parses flags that may appear before, between or after
+// positional arguments, which flag.FlagSet.Parse alone stops at, and returns
+// the positional arguments. Arguments after "--" are always positional.
+func parseInterleaved(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+		rest := flags.Args()
+		if consumed := len(args) - len(rest); consumed > 0 && args[consumed-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// printJSON writes value to stdout as indented JSON
+func printJSON(value interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// newIdentityClient creates an auth client that calls the Globus Auth API
+// with the user's token
+func newIdentityClient(config *Config, accessToken string) (*sdkauth.Client, error) {
+	return sdkauth.NewClient(
+		sdkauth.WithClientID(config.ClientID),
+		sdkauth.WithCoreOption(core.WithAuthorizer(authorizers.StaticTokenCoreAuthorizer(accessToken))),
+	)
+}
+
+// WhoamiCommand handles the whoami command
+func WhoamiCommand(args []string) error {
+	flags := flag.NewFlagSet("whoami", flag.ContinueOnError)
+	format := addFormatFlag(flags)
+	linked := flags.Bool("linked-identities", false, "also list the identities linked to the account")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := checkFormat(*format); err != nil {
+		return err
+	}
+
+	// Load the configuration
+	config, err := LoadOrCreateConfig()
+	if err != nil {
+		return fmt.Errorf("error loading configuration: %w", err)
+	}
+
+	// Check if we have a token
+	token, err := LoadToken(config, DefaultTokenFile)
+	if err != nil {
+		return fmt.Errorf("not logged in: %w", err)
+	}
+
+	authClient, err := newIdentityClient(config, token.AccessToken)
+	if err != nil {
+		return fmt.Errorf("error creating auth client: %w", err)
+	}
+
+	ctx := context.Background()
+	userInfo, err := authClient.GetUserInfo(ctx, token.AccessToken)
+	if err != nil {
+		return fmt.Errorf("error getting user information: %w", err)
+	}
+	output := whoamiOutput{UserInfo: userInfo}
+
+	if *linked {
+		if len(userInfo.IdentitySet) == 0 {
+			return fmt.Errorf("the saved login cannot list linked identities, log in with: globus-cli login --scope %s",
+				scopes.AuthViewIdentitySet)
+		}
+
+		ids := make([]string, 0, len(userInfo.IdentitySet))
+		for _, entry := range userInfo.IdentitySet {
+			ids = append(ids, entry.Subject)
+		}
+		identities, err := authClient.GetIdentities(ctx, &sdkauth.GetIdentitiesOptions{IDs: ids})
+		if err != nil {
+			return fmt.Errorf("error getting linked identities: %w", err)
+		}
+		output.Identities = identities.Identities
+	}
+
+	if *format == FormatJSON {
+		return printJSON(output)
+	}
+
+	fmt.Println(userInfo.PreferredUsername)
+	if len(output.Identities) > 0 {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "\nUsername\tName\tID\tStatus")
+		for _, identity := range output.Identities {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", identity.Username, identity.Name, identity.IdentityID, identity.Status)
+		}
+		return w.Flush()
+	}
+
+	return nil
+}
+
+// SessionCommand handles the session command
+func SessionCommand(args []string) error {
+	// Check if we have a subcommand
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		switch args[0] {
+		case "show":
+			return showSession(args[1:])
+		case "update":
+			return updateSession(args[1:])
+		default:
+			return fmt.Errorf("unknown subcommand: %s", args[0])
+		}
+	}
+
+	// Default to showing the session
+	return showSession(args)
+}
+
+// showSession lists the identities linked to the user and when each was
+// used to log in during the current session
+func showSession(args []string) error {
+	flags := flag.NewFlagSet("session show", flag.ContinueOnError)
+	format := addFormatFlag(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := checkFormat(*format); err != nil {
+		return err
+	}
+
+	// Load the configuration
+	config, err := LoadOrCreateConfig()
+	if err != nil {
+		return fmt.Errorf("error loading configuration: %w", err)
+	}
+
+	// Check if we have a token
+	token, err := LoadToken(config, DefaultTokenFile)
+	if err != nil {
+		return fmt.Errorf("not logged in: %w", err)
+	}
+
+	identities, err := loadSession(context.Background(), config, token)
+	if err != nil {
+		return err
+	}
+	output := sessionOutput{Identities: identities}
+
+	if *format == FormatJSON {
+		return printJSON(output)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Username\tID\tAuth Time")
+	for _, identity := range output.Identities {
+		authTime := ""
+		if identity.AuthTime != nil {
+			authTime = identity.AuthTime.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", identity.Username, identity.IdentityID, authTime)
+	}
+	return w.Flush()
+}
+
+// loadSession returns the identities linked to the user and when each last
+// logged in during the current session. It reads the userinfo endpoint with
+// the user's token: token introspection would need a confidential client,
+// and the CLI's default client is a native app.
+func loadSession(ctx context.Context, config *Config, token *TokenInfo) ([]sessionIdentity, error) {
+	authClient, err := newIdentityClient(config, token.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("error creating auth client: %w", err)
+	}
+	userInfo, err := authClient.GetUserInfo(ctx, token.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("error getting session information: %w", err)
+	}
+	if len(userInfo.IdentitySet) == 0 {
+		return nil, fmt.Errorf("the saved login cannot list linked identities, log in with: globus-cli login --scope %s",
+			scopes.AuthViewIdentitySet)
+	}
+
+	identities := make([]sessionIdentity, 0, len(userInfo.IdentitySet))
+	for _, entry := range userInfo.IdentitySet {
+		identity := sessionIdentity{Identity: sdkauth.Identity{
+			IdentityID:       entry.Subject,
+			Username:         entry.Username,
+			Name:             entry.Name,
+			Email:            entry.Email,
+			IdentityProvider: entry.IdentityProvider,
+			Organization:     entry.Organization,
+		}}
+		// Identities that have not logged in during the session have no
+		// last_authentication
+		if entry.LastAuthentication > 0 {
+			authTime := time.Unix(entry.LastAuthentication, 0)
+			identity.AuthTime = &authTime
+		}
+		identities = append(identities, identity)
+	}
+	return identities, nil
+}
+
+// updateSession runs a step-up login that adds the given identities, or all
+// linked identities with --all, to the session
+func updateSession(args []string) error {
+	options := &loginOptions{}
+	flags := flag.NewFlagSet("session update", flag.ContinueOnError)
+	addBrowserFlags(flags, options)
+	all := flags.Bool("all", false, "log in with every identity linked to the account")
+	format := addFormatFlag(flags)
+	values, err := parseInterleaved(flags, args)
+	if err != nil {
+		return err
+	}
+	if err := checkFormat(*format); err != nil {
+		return err
+	}
+	if *all == (len(values) > 0) {
+		return fmt.Errorf("give either identities (usernames or IDs) or --all")
+	}
+
+	// Load the configuration
+	config, err := LoadOrCreateConfig()
+	if err != nil {
+		return fmt.Errorf("error loading configuration: %w", err)
+	}
+
+	// Check if we have a token
+	token, err := LoadToken(config, DefaultTokenFile)
+	if err != nil {
+		return fmt.Errorf("not logged in: %w", err)
+	}
+
+	ctx := context.Background()
+	params := sdkauth.AuthorizationParameters{SessionMessage: "globus-cli session update"}
+	if *all {
+		identities, err := loadSession(ctx, config, token)
+		if err != nil {
+			return err
+		}
+		for _, identity := range identities {
+			params.SessionRequiredIdentities = append(params.SessionRequiredIdentities, identity.IdentityID)
+		}
+		params.Prompt = "login"
+	} else {
+		params.SessionRequiredIdentities, err = resolveIdentities(ctx, config, token, values)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Keep every granted scope so the new tokens replace the old ones
+	granted, err := grantedScopes(config)
+	if err != nil {
+		return fmt.Errorf("error reading saved tokens: %w", err)
+	}
+	if err := runLogin(config, options, params, granted); err != nil {
+		return err
+	}
+
+	// Show the updated session
+	return showSession([]string{"--format", *format})
+}
+
+// resolveIdentities converts usernames to identity IDs. Arguments that are
+// already IDs are kept as they are.
+func resolveIdentities(ctx context.Context, config *Config, token *TokenInfo, values []string) ([]string, error) {
+	var ids, usernames []string
+	for _, value := range values {
+		if _, err := uuid.Parse(value); err == nil {
+			ids = append(ids, value)
+		} else {
+			usernames = append(usernames, value)
+		}
+	}
+	if len(usernames) == 0 {
+		return ids, nil
+	}
+
+	identityClient, err := newIdentityClient(config, token.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("error creating auth client: %w", err)
+	}
+	identities, err := identityClient.GetIdentities(ctx, &sdkauth.GetIdentitiesOptions{Usernames: usernames})
+	if err != nil {
+		return nil, fmt.Errorf("error looking up identities: %w", err)
+	}
+
+	for _, username := range usernames {
+		found := false
+		for _, identity := range identities.Identities {
+			if strings.EqualFold(identity.Username, username) {
+				ids = append(ids, identity.IdentityID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no identity found for %s", username)
+		}
+	}
+	return ids, nil
+}
@@ -46,7 +46,7 @@ func main() {
 		{
 			Name:        "login",
 			Description: "Log in to Globus",
-			Usage:       "globus-cli login",
+			Usage:       "globus-cli login [--no-local-server] [--no-browser] [--port N] [--service name]... [--scope scope]... [--force]",
 			Execute:     auth.LoginCommand,
 		},
 		{
@@ -55,6 +55,18 @@ func main() {
 			Usage:       "globus-cli token [info|revoke] [token]",
 			Execute:     auth.TokenCommand,
 		},
+		{
+			Name:        "whoami",
+			Description: "Show the logged in user",
+			Usage:       "globus-cli whoami [--linked-identities] [--format text|json]",
+			Execute:     auth.WhoamiCommand,
+		},
+		{
+			Name:        "session",
+			Description: "Show or update the identities in the login session",
+			Usage:       "globus-cli session [show|update] [--all] [--format text|json] [identity]...",
+			Execute:     auth.SessionCommand,
+		},
 		{
 			Name:        "logout",
 			Description: "Log out from Globus",
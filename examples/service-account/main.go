@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/authorizers"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/scopes"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/services/auth"
+)
+
+func main() {
+	// The token must carry the auth.globus.org manage_projects scope
+	accessToken := os.Getenv("GLOBUS_ACCESS_TOKEN")
+	projectID := os.Getenv("GLOBUS_PROJECT_ID")
+	if accessToken == "" || projectID == "" {
+		fmt.Println("Please set the GLOBUS_ACCESS_TOKEN and GLOBUS_PROJECT_ID environment variables")
+		os.Exit(1)
+	}
+
+	name := "example-service-account"
+	if len(os.Args) > 1 {
+		name = os.Args[1]
+	}
+
+	authClient, err := auth.NewClient(
+		auth.WithCoreOption(core.WithAuthorizer(authorizers.StaticTokenCoreAuthorizer(accessToken))),
+	)
+	if err != nil {
+		fmt.Printf("Error creating auth client: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+
+	// Register the service account and its first secret
+	account, credential, err := authClient.CreateServiceAccount(ctx, projectID, name)
+	if err != nil {
+		fmt.Printf("Error creating service account: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Created service account %s (%s)\n", account.Name, account.ID)
+	fmt.Printf("Client secret (store it now, it cannot be retrieved again): %s\n", credential.Secret)
+
+	// Look up the Transfer scope so the new scope can depend on it
+	transferScopes, err := authClient.ListScopes(ctx, &auth.ListScopesOptions{
+		ScopeStrings: []string{scopes.TransferAll},
+	})
+	if err != nil || len(transferScopes) == 0 {
+		fmt.Printf("Error looking up the Transfer scope: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Define a scope that lets the service act on Transfer for its users
+	allowsRefresh := true
+	scope, err := authClient.CreateScope(ctx, account.ID, &auth.ClientScopeCreate{
+		Name:               name + " access",
+		Description:        "Allows " + name + " to move data on your behalf",
+		ScopeSuffix:        "all",
+		AllowsRefreshToken: &allowsRefresh,
+		DependentScopes: []auth.DependentScope{
+			{ScopeID: transferScopes[0].ID, RequiresRefreshToken: true},
+		},
+	})
+	if err != nil {
+		fmt.Printf("Error creating scope: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Created scope %s\n", scope.ScopeString)
+
+	credentials, err := authClient.ListClientCredentials(ctx, account.ID)
+	if err != nil {
+		fmt.Printf("Error listing credentials: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Service account has %d credential(s)\n", len(credentials))
+}
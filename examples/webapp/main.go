@@ -338,6 +338,11 @@ func (app *App) handleLogout(w http.ResponseWriter, r *http.Request) {
 	// Remove session
 	delete(app.Sessions, session.ID)
 
+	// Revoke and forget the user's tokens
+	if err := app.TokenManager.RevokeUser(r.Context(), session.UserID); err != nil {
+		log.Printf("Failed to revoke tokens for %s: %v", session.UserID, err)
+	}
+
 	// Clear session cookie
 	http.SetCookie(w, &http.Cookie{
 		Name:     sessionCookieName,
@@ -799,11 +804,17 @@ func (app *App) getSession(r *http.Request) *Session {
 	return session
 }
 
-// storeTokens stores tokens for a user
+// storeTokens stores tokens for a user in the user's own namespace, keyed by
+// resource server, so tokens from different users never collide
 func (app *App) storeTokens(userID string, tokenResponse *auth.TokenResponse) error {
+	resource := tokenResponse.ResourceServer
+	if resource == "" {
+		resource = "auth.globus.org"
+	}
+
 	// Create a token entry with TokenSet
 	entry := &tokens.Entry{
-		Resource: userID,
+		Resource: resource,
 		TokenSet: &tokens.TokenSet{
 			AccessToken:  tokenResponse.AccessToken,
 			RefreshToken: tokenResponse.RefreshToken,
@@ -813,23 +824,32 @@ func (app *App) storeTokens(userID string, tokenResponse *auth.TokenResponse) er
 	}
 
 	// Store the tokens
-	return app.TokenStorage.Store(entry)
+	return app.TokenManager.ForUser(userID).StoreToken(context.Background(), entry)
 }
 
 // getAccessToken gets an access token for the specified user and scope
 func (app *App) getAccessToken(ctx context.Context, userID, scope string) (string, error) {
-	// Use the TokenManager to get (and potentially refresh) the token
-	entry, err := app.TokenManager.GetToken(ctx, userID)
+	userTokens := app.TokenManager.ForUser(userID)
+
+	resources, err := userTokens.List()
 	if err != nil {
-		return "", fmt.Errorf("failed to get token: %w", err)
+		return "", fmt.Errorf("failed to list tokens: %w", err)
 	}
 
-	// Check if token has the required scope
-	if entry.TokenSet.Scope == "" || containsScope(entry.TokenSet.Scope, scope) {
-		return entry.TokenSet.AccessToken, nil
+	for _, resource := range resources {
+		// Use the TokenManager to get (and potentially refresh) the token
+		entry, err := userTokens.GetToken(ctx, resource)
+		if err != nil {
+			return "", fmt.Errorf("failed to get token: %w", err)
+		}
+
+		// Check if token has the required scope
+		if entry.TokenSet.Scope == "" || containsScope(entry.TokenSet.Scope, scope) {
+			return entry.TokenSet.AccessToken, nil
+		}
 	}
 
-	return "", fmt.Errorf("token does not have required scope %s", scope)
+	return "", fmt.Errorf("no token with required scope %s", scope)
 }
 
 // getUserInfo retrieves user information from Globus Auth
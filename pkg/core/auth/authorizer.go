@@ -2,10 +2,42 @@
 // Copyright (c) 2025 Scott Friedman and Project Contributors
 package auth
 
-import "context"
+import (
+	"context"
+	"strings"
+)
 
 // Authorizer defines the interface for components that can authorize HTTP requests
 type Authorizer interface {
 	// GetAuthorizationHeader returns the authorization header value
 	GetAuthorizationHeader(ctx ...context.Context) (string, error)
 }
+
+// MissingAuthorizationHandler is implemented by authorizers that can obtain
+// new credentials after a request is rejected with 401 Unauthorized
+type MissingAuthorizationHandler interface {
+	// HandleMissingAuthorization refreshes the credentials and returns true
+	// if the rejected request should be retried
+	HandleMissingAuthorization(ctx context.Context) bool
+}
+
+// rejectedAuthorizationKey is the context key for WithRejectedAuthorization
+type rejectedAuthorizationKey struct{}
+
+// WithRejectedAuthorization returns a context carrying the Authorization
+// header of a request the server rejected. Clients pass it to
+// HandleMissingAuthorization so an authorizer can tell whether its token was
+// already replaced after the request was sent.
+func WithRejectedAuthorization(ctx context.Context, header string) context.Context {
+	return context.WithValue(ctx, rejectedAuthorizationKey{}, header)
+}
+
+// RejectedToken returns the bearer token of the rejected request carried by
+// ctx, if any
+func RejectedToken(ctx context.Context) (string, bool) {
+	header, ok := ctx.Value(rejectedAuthorizationKey{}).(string)
+	if !ok {
+		return "", false
+	}
+	return strings.TrimPrefix(header, "Bearer "), true
+}
@@ -29,6 +29,11 @@ func (a *CoreAuthorizer) GetAuthorizationHeader(ctxArr ...context.Context) (stri
 	return a.Authorizer.GetAuthorizationHeader(ctx)
 }
 
+// HandleMissingAuthorization implements the auth.MissingAuthorizationHandler interface
+func (a *CoreAuthorizer) HandleMissingAuthorization(ctx context.Context) bool {
+	return a.Authorizer.HandleMissingAuthorization(ctx)
+}
+
 // ToCore adapts any Authorizer to an auth.Authorizer
 func ToCore(a Authorizer) auth.Authorizer {
 	return NewCoreAuthorizer(a)
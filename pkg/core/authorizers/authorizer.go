@@ -4,7 +4,10 @@ package authorizers
 
 import (
 	"context"
+	"sync"
 	"time"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/auth"
 )
 
 // Authorizer defines the interface for authorization mechanisms
@@ -66,12 +69,74 @@ func (a *StaticTokenAuthorizer) IsExpired() bool {
 	return false
 }
 
-// RefreshableTokenAuthorizer implements Authorizer with a refreshable token
-type RefreshableTokenAuthorizer struct {
+// TokenChange describes the tokens an authorizer obtained from a refresh
+type TokenChange struct {
 	AccessToken  string
 	RefreshToken string
 	ExpiresAt    time.Time
-	RefreshFunc  func(ctx context.Context, refreshToken string) (string, string, time.Time, error)
+	Scopes       []string
+}
+
+// TokenChangeFunc is called after an authorizer obtains new tokens, typically
+// to persist them. It is called once per refresh, outside the authorizer's lock.
+type TokenChangeFunc func(ctx context.Context, change TokenChange)
+
+// refreshGroup runs at most one refresh at a time. Callers that arrive while
+// a refresh is in flight wait for it and share its result. The refresh runs
+// without the cancellation of the caller that started it; a caller whose
+// context ends stops waiting and leaves the refresh to finish for the others.
+type refreshGroup struct {
+	mu   sync.Mutex
+	call *refreshCall
+}
+
+// refreshCall is a refresh in flight
+type refreshCall struct {
+	done chan struct{}
+	err  error
+}
+
+// do starts fn unless a refresh is already in flight, and waits for the
+// refresh to finish or ctx to end
+func (g *refreshGroup) do(ctx context.Context, fn func(ctx context.Context) error) error {
+	g.mu.Lock()
+	call := g.call
+	if call == nil {
+		call = &refreshCall{done: make(chan struct{})}
+		g.call = call
+		refreshCtx := context.WithoutCancel(ctx)
+		go func() {
+			call.err = fn(refreshCtx)
+
+			g.mu.Lock()
+			g.call = nil
+			g.mu.Unlock()
+			close(call.done)
+		}()
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RefreshableTokenAuthorizer implements Authorizer with a refreshable token.
+// It is safe for concurrent use; concurrent refreshes share a single call to
+// RefreshFunc so rotating refresh tokens are only used once. The exported
+// fields must not be modified once the authorizer is shared.
+type RefreshableTokenAuthorizer struct {
+	AccessToken   string
+	RefreshToken  string
+	ExpiresAt     time.Time
+	RefreshFunc   func(ctx context.Context, refreshToken string) (string, string, time.Time, error)
+	OnTokenChange TokenChangeFunc
+
+	mu      sync.RWMutex
+	refresh refreshGroup
 }
 
 // NewRefreshableTokenAuthorizer creates a new RefreshableTokenAuthorizer
@@ -89,56 +154,127 @@ func NewRefreshableTokenAuthorizer(
 	}
 }
 
-// GetAuthorizationHeader returns the authorization header with the access token
-func (a *RefreshableTokenAuthorizer) GetAuthorizationHeader(_ context.Context) (string, error) {
+// GetAuthorizationHeader returns the authorization header with the access
+// token, refreshing it first if it has expired and can be refreshed
+func (a *RefreshableTokenAuthorizer) GetAuthorizationHeader(ctx context.Context) (string, error) {
+	if a.IsExpired() && a.canRefresh() {
+		if err := a.refreshTokens(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
 	if a.AccessToken == "" {
 		return "", nil
 	}
 	return "Bearer " + a.AccessToken, nil
 }
 
-// HandleMissingAuthorization refreshes the token if expired
+// HandleMissingAuthorization refreshes the token. It is called after the
+// server rejects the current access token, so the token is refreshed even if
+// it has not reached its expiry time (for example, because it was revoked).
+// If ctx carries the rejected token and it has already been replaced, the
+// request is retried without another refresh.
 func (a *RefreshableTokenAuthorizer) HandleMissingAuthorization(ctx context.Context) bool {
-	// If no refresh function or token, can't handle
-	if a.RefreshFunc == nil || a.RefreshToken == "" {
-		return false
+	if a.replacedSince(ctx) {
+		return true
 	}
 
-	// If not expired, no need to refresh
-	if !a.IsExpired() {
+	// If no refresh function or token, can't handle
+	if !a.canRefresh() {
 		return false
 	}
+	return a.refreshTokens(ctx) == nil
+}
 
-	// Refresh the token
-	accessToken, refreshToken, expiresAt, err := a.RefreshFunc(ctx, a.RefreshToken)
-	if err != nil {
-		return false
+// IsExpired checks if the token is expired
+func (a *RefreshableTokenAuthorizer) IsExpired() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	// Add a buffer of 30 seconds to avoid edge cases
+	return time.Now().Add(30 * time.Second).After(a.ExpiresAt)
+}
+
+// Token returns the current tokens
+func (a *RefreshableTokenAuthorizer) Token() TokenChange {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return TokenChange{
+		AccessToken:  a.AccessToken,
+		RefreshToken: a.RefreshToken,
+		ExpiresAt:    a.ExpiresAt,
 	}
+}
 
-	// Update tokens
-	a.AccessToken = accessToken
-	if refreshToken != "" {
-		a.RefreshToken = refreshToken
+// replacedSince reports whether the access token differs from the rejected
+// token carried by ctx
+func (a *RefreshableTokenAuthorizer) replacedSince(ctx context.Context) bool {
+	rejected, ok := auth.RejectedToken(ctx)
+	if !ok {
+		return false
 	}
-	a.ExpiresAt = expiresAt
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.AccessToken != "" && a.AccessToken != rejected
+}
 
-	return true
+// canRefresh reports whether a refresh function and refresh token are available
+func (a *RefreshableTokenAuthorizer) canRefresh() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.RefreshFunc != nil && a.RefreshToken != ""
 }
 
-// IsExpired checks if the token is expired
-func (a *RefreshableTokenAuthorizer) IsExpired() bool {
-	// Add a buffer of 30 seconds to avoid edge cases
-	return time.Now().Add(30 * time.Second).After(a.ExpiresAt)
+// refreshTokens refreshes the tokens, sharing any refresh already in flight
+func (a *RefreshableTokenAuthorizer) refreshTokens(ctx context.Context) error {
+	return a.refresh.do(ctx, func(ctx context.Context) error {
+		a.mu.RLock()
+		refreshFunc, currentRefreshToken := a.RefreshFunc, a.RefreshToken
+		a.mu.RUnlock()
+
+		accessToken, refreshToken, expiresAt, err := refreshFunc(ctx, currentRefreshToken)
+		if err != nil {
+			return err
+		}
+
+		// Update tokens
+		a.mu.Lock()
+		a.AccessToken = accessToken
+		if refreshToken != "" {
+			a.RefreshToken = refreshToken
+		}
+		a.ExpiresAt = expiresAt
+		change := TokenChange{
+			AccessToken:  a.AccessToken,
+			RefreshToken: a.RefreshToken,
+			ExpiresAt:    a.ExpiresAt,
+		}
+		onChange := a.OnTokenChange
+		a.mu.Unlock()
+
+		if onChange != nil {
+			onChange(ctx, change)
+		}
+		return nil
+	})
 }
 
-// ClientCredentialsAuthorizer implements Authorizer using client credentials flow
+// ClientCredentialsAuthorizer implements Authorizer using client credentials
+// flow. It is safe for concurrent use; concurrent callers share a single call
+// to AuthFunc. The exported fields must not be modified once the authorizer
+// is shared.
 type ClientCredentialsAuthorizer struct {
-	ClientID     string
-	ClientSecret string
-	Scopes       []string
-	AccessToken  string
-	ExpiresAt    time.Time
-	AuthFunc     func(ctx context.Context, clientID, clientSecret string, scopes []string) (string, time.Time, error)
+	ClientID      string
+	ClientSecret  string
+	Scopes        []string
+	AccessToken   string
+	ExpiresAt     time.Time
+	AuthFunc      func(ctx context.Context, clientID, clientSecret string, scopes []string) (string, time.Time, error)
+	OnTokenChange TokenChangeFunc
+
+	mu      sync.RWMutex
+	refresh refreshGroup
 }
 
 // NewClientCredentialsAuthorizer creates a new ClientCredentialsAuthorizer
@@ -165,13 +301,24 @@ func (a *ClientCredentialsAuthorizer) GetAuthorizationHeader(ctx context.Context
 		}
 	}
 
+	a.mu.RLock()
+	defer a.mu.RUnlock()
 	return "Bearer " + a.AccessToken, nil
 }
 
-// HandleMissingAuthorization refreshes the token
+// HandleMissingAuthorization refreshes the token, unless ctx carries the
+// rejected token and it has already been replaced
 func (a *ClientCredentialsAuthorizer) HandleMissingAuthorization(ctx context.Context) bool {
+	a.mu.RLock()
+	authFunc, current := a.AuthFunc, a.AccessToken
+	a.mu.RUnlock()
+
+	if rejected, ok := auth.RejectedToken(ctx); ok && current != "" && current != rejected {
+		return true
+	}
+
 	// If no authFunc, can't handle
-	if a.AuthFunc == nil {
+	if authFunc == nil {
 		return false
 	}
 	err := a.refreshToken(ctx)
@@ -180,21 +327,57 @@ func (a *ClientCredentialsAuthorizer) HandleMissingAuthorization(ctx context.Con
 
 // IsExpired checks if the token is expired
 func (a *ClientCredentialsAuthorizer) IsExpired() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
 	return a.AccessToken == "" || time.Now().Add(30*time.Second).After(a.ExpiresAt)
 }
 
-// refreshToken gets a new token using client credentials
+// Token returns the current token
+func (a *ClientCredentialsAuthorizer) Token() TokenChange {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return TokenChange{
+		AccessToken: a.AccessToken,
+		ExpiresAt:   a.ExpiresAt,
+		Scopes:      append([]string(nil), a.Scopes...),
+	}
+}
+
+// refreshToken gets a new token using client credentials, sharing any
+// request already in flight
 func (a *ClientCredentialsAuthorizer) refreshToken(ctx context.Context) error {
-	if a.AuthFunc == nil {
+	a.mu.RLock()
+	authFunc := a.AuthFunc
+	a.mu.RUnlock()
+
+	if authFunc == nil {
 		return nil
 	}
 
-	accessToken, expiresAt, err := a.AuthFunc(ctx, a.ClientID, a.ClientSecret, a.Scopes)
-	if err != nil {
-		return err
-	}
+	return a.refresh.do(ctx, func(ctx context.Context) error {
+		a.mu.RLock()
+		clientID, clientSecret, scopes := a.ClientID, a.ClientSecret, a.Scopes
+		a.mu.RUnlock()
+
+		accessToken, expiresAt, err := authFunc(ctx, clientID, clientSecret, scopes)
+		if err != nil {
+			return err
+		}
 
-	a.AccessToken = accessToken
-	a.ExpiresAt = expiresAt
-	return nil
+		a.mu.Lock()
+		a.AccessToken = accessToken
+		a.ExpiresAt = expiresAt
+		change := TokenChange{
+			AccessToken: accessToken,
+			ExpiresAt:   expiresAt,
+			Scopes:      append([]string(nil), scopes...),
+		}
+		onChange := a.OnTokenChange
+		a.mu.Unlock()
+
+		if onChange != nil {
+			onChange(ctx, change)
+		}
+		return nil
+	})
 }
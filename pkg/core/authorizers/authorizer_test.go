@@ -4,8 +4,12 @@ package authorizers
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/auth"
 )
 
 func TestNullAuthorizer(t *testing.T) {
@@ -112,12 +116,12 @@ func TestRefreshableTokenAuthorizer(t *testing.T) {
 		t.Error("RefreshableTokenAuthorizer.IsExpired returned false for an expired token")
 	}
 
-	// Test HandleMissingAuthorization with non-expired token
-	if auth.HandleMissingAuthorization(context.Background()) {
-		t.Error("RefreshableTokenAuthorizer.HandleMissingAuthorization returned true for non-expired token")
+	// Test HandleMissingAuthorization with a rejected but non-expired token
+	if !auth.HandleMissingAuthorization(context.Background()) {
+		t.Error("RefreshableTokenAuthorizer.HandleMissingAuthorization returned false for rejected token")
 	}
-	if refreshCalled {
-		t.Error("RefreshFunc was called for non-expired token")
+	if !refreshCalled {
+		t.Error("RefreshFunc was not called for rejected token")
 	}
 
 	// Test HandleMissingAuthorization with expired token
@@ -220,3 +224,188 @@ func TestClientCredentialsAuthorizer(t *testing.T) {
 		t.Error("ClientCredentialsAuthorizer(no func).HandleMissingAuthorization returned true")
 	}
 }
+
+func TestRefreshableTokenAuthorizerSingleFlight(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	refreshFunc := func(ctx context.Context, refreshToken string) (string, string, time.Time, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		if refreshToken != "refresh-1" {
+			t.Errorf("RefreshFunc received refresh token %q, want refresh-1", refreshToken)
+		}
+		return "access-2", "refresh-2", time.Now().Add(time.Hour), nil
+	}
+
+	var changes []TokenChange
+	var changesMu sync.Mutex
+	auth := NewRefreshableTokenAuthorizer("access-1", "refresh-1", -10, refreshFunc)
+	auth.OnTokenChange = func(ctx context.Context, change TokenChange) {
+		changesMu.Lock()
+		defer changesMu.Unlock()
+		changes = append(changes, change)
+	}
+
+	var wg sync.WaitGroup
+	headers := make([]string, 20)
+	for i := range headers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			header, err := auth.GetAuthorizationHeader(context.Background())
+			if err != nil {
+				t.Errorf("GetAuthorizationHeader returned error: %v", err)
+			}
+			headers[i] = header
+		}(i)
+	}
+
+	// Give the goroutines time to pile up behind the first refresh
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("RefreshFunc called %d times, want 1", got)
+	}
+	for _, header := range headers {
+		if header != "Bearer access-2" {
+			t.Errorf("GetAuthorizationHeader returned %q, want refreshed token", header)
+		}
+	}
+	if len(changes) != 1 || changes[0].AccessToken != "access-2" || changes[0].RefreshToken != "refresh-2" {
+		t.Errorf("OnTokenChange calls = %+v, want one change with refreshed tokens", changes)
+	}
+	if token := auth.Token(); token.RefreshToken != "refresh-2" {
+		t.Errorf("Token().RefreshToken = %q, want refresh-2", token.RefreshToken)
+	}
+}
+
+func TestRefreshableTokenAuthorizerRejectedToken(t *testing.T) {
+	var calls int32
+	refreshFunc := func(ctx context.Context, refreshToken string) (string, string, time.Time, error) {
+		atomic.AddInt32(&calls, 1)
+		return "access-3", "refresh-3", time.Now().Add(time.Hour), nil
+	}
+	authorizer := NewRefreshableTokenAuthorizer("access-2", "refresh-2", 3600, refreshFunc)
+
+	// A request that carried a token replaced since then is retried as is
+	ctx := auth.WithRejectedAuthorization(context.Background(), "Bearer access-1")
+	if !authorizer.HandleMissingAuthorization(ctx) {
+		t.Error("HandleMissingAuthorization returned false for a replaced token")
+	}
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Errorf("RefreshFunc called %d times for a replaced token, want 0", got)
+	}
+
+	ctx = auth.WithRejectedAuthorization(context.Background(), "Bearer access-2")
+	if !authorizer.HandleMissingAuthorization(ctx) {
+		t.Error("HandleMissingAuthorization returned false for the current token")
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("RefreshFunc called %d times for the current token, want 1", got)
+	}
+}
+
+func TestRefreshableTokenAuthorizerCanceledLeader(t *testing.T) {
+	var once sync.Once
+	started := make(chan struct{})
+	release := make(chan struct{})
+	refreshFunc := func(ctx context.Context, refreshToken string) (string, string, time.Time, error) {
+		once.Do(func() { close(started) })
+		<-release
+		if err := ctx.Err(); err != nil {
+			return "", "", time.Time{}, err
+		}
+		return "access-2", "refresh-2", time.Now().Add(time.Hour), nil
+	}
+	authorizer := NewRefreshableTokenAuthorizer("access-1", "refresh-1", 3600, refreshFunc)
+
+	// The caller that starts the refresh gives up, but the refresh continues
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leader := make(chan bool)
+	go func() {
+		leader <- authorizer.HandleMissingAuthorization(leaderCtx)
+	}()
+	<-started
+
+	follower := make(chan bool)
+	go func() {
+		follower <- authorizer.HandleMissingAuthorization(context.Background())
+	}()
+
+	cancel()
+	if <-leader {
+		t.Error("HandleMissingAuthorization returned true after its context was canceled")
+	}
+	close(release)
+	if !<-follower {
+		t.Error("Waiting caller did not share the refresh")
+	}
+	if token := authorizer.Token(); token.AccessToken != "access-2" {
+		t.Errorf("Token().AccessToken = %q, want access-2", token.AccessToken)
+	}
+}
+
+func TestClientCredentialsAuthorizerSingleFlight(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	authFunc := func(ctx context.Context, clientID, clientSecret string, scopes []string) (string, time.Time, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return "cc-token", time.Now().Add(time.Hour), nil
+	}
+
+	auth := NewClientCredentialsAuthorizer("client", "secret", []string{"scope1"}, authFunc)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !auth.HandleMissingAuthorization(context.Background()) {
+				t.Error("HandleMissingAuthorization returned false")
+			}
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("AuthFunc called %d times, want 1", got)
+	}
+}
+
+func TestStoreTokenChanges(t *testing.T) {
+	storage := auth.NewMemoryTokenStorage()
+	ctx := context.Background()
+	storage.StoreToken(ctx, "transfer", auth.TokenInfo{
+		AccessToken:  "old-access",
+		RefreshToken: "old-refresh",
+		Scopes:       []string{"urn:globus:auth:scope:transfer.api.globus.org:all"},
+		ResourceID:   "transfer.api.globus.org",
+	})
+
+	refreshFunc := func(ctx context.Context, refreshToken string) (string, string, time.Time, error) {
+		return "new-access", "new-refresh", time.Now().Add(time.Hour), nil
+	}
+	authorizer := NewRefreshableTokenAuthorizer("old-access", "old-refresh", 3600, refreshFunc)
+	authorizer.OnTokenChange = StoreTokenChanges(storage, "transfer")
+
+	if !authorizer.HandleMissingAuthorization(ctx) {
+		t.Fatal("HandleMissingAuthorization returned false")
+	}
+
+	stored, err := storage.GetToken(ctx, "transfer")
+	if err != nil {
+		t.Fatalf("GetToken returned error: %v", err)
+	}
+	if stored.AccessToken != "new-access" || stored.RefreshToken != "new-refresh" {
+		t.Errorf("Stored tokens = %q/%q, want new-access/new-refresh", stored.AccessToken, stored.RefreshToken)
+	}
+	if stored.ResourceID != "transfer.api.globus.org" || len(stored.Scopes) != 1 {
+		t.Errorf("Stored token lost resource ID or scopes: %+v", stored)
+	}
+}
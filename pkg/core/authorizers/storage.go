@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package authorizers
+
+import (
+	"context"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/auth"
+)
+
+// StoreTokenChanges returns a TokenChangeFunc that saves refreshed tokens to
+// storage under key. Scopes and resource ID already stored under the key are
+// kept when the change does not carry them. Storage errors are ignored so
+// that a failed save does not fail the request that triggered the refresh.
+func StoreTokenChanges(storage auth.TokenStorage, key string) TokenChangeFunc {
+	return func(ctx context.Context, change TokenChange) {
+		token := auth.TokenInfo{}
+		if existing, err := storage.GetToken(ctx, key); err == nil {
+			token = existing
+		}
+
+		token.AccessToken = change.AccessToken
+		if change.RefreshToken != "" {
+			token.RefreshToken = change.RefreshToken
+		}
+		token.ExpiresAt = change.ExpiresAt
+		if len(change.Scopes) > 0 {
+			token.Scopes = change.Scopes
+		}
+
+		_ = storage.StoreToken(ctx, key, token)
+	}
+}
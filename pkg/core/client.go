@@ -21,6 +21,7 @@ type Client struct {
 	Authorizer   auth.Authorizer
 	RateLimiter  ratelimit.RateLimiter
 	Transport    interfaces.Transport
+	Middleware   []Middleware
 	Debug        bool
 	Trace        bool
 	VersionCheck *VersionCheck
@@ -112,37 +113,14 @@ func WithVersionCheck(versionCheck *VersionCheck) ClientOption {
 	}
 }
 
-// Do performs an HTTP request and handles common error cases
+// Do performs an HTTP request and handles common error cases.
+//
+// If the server responds with 401 Unauthorized and the authorizer implements
+// auth.MissingAuthorizationHandler, the authorizer is asked to refresh its
+// credentials once and the request is replayed with a rewound body.
 func (c *Client) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
-	// Set common headers
-	req.Header.Set("User-Agent", c.UserAgent)
-
-	// Apply authorization if available
-	if c.Authorizer != nil {
-		header, err := c.Authorizer.GetAuthorizationHeader(ctx)
-		if err != nil {
-			return nil, err
-		}
-		if header != "" {
-			req.Header.Set("Authorization", header)
-		}
-	}
-
-	// Apply rate limiting if configured
-	if c.RateLimiter != nil {
-		if err := c.RateLimiter.Wait(ctx); err != nil {
-			c.Logger.Error("Rate limiting failed: %v", err)
-			return nil, err
-		}
-	}
-
-	// Log the request
-	c.Logger.Debug("Making request to %s %s", req.Method, req.URL.String())
-
-	// Execute request with context
-	resp, err := c.HTTPClient.Do(req.WithContext(ctx))
+	resp, err := c.send(ctx, req)
 	if err != nil {
-		c.Logger.Error("Request failed: %v", err)
 		return nil, err
 	}
 
@@ -156,6 +134,86 @@ func (c *Client) Do(ctx context.Context, req *http.Request) (*http.Response, err
 	return resp, nil
 }
 
+// send passes a request through the client's middleware chain. From the
+// outside in, the chain runs the middleware added with WithMiddleware, logs
+// the request, sets the user agent, replays the request after a 401,
+// authorizes it and waits for the rate limiter before the HTTP client sends
+// it. Every attempt made by retrying middleware is therefore authorized and
+// rate limited on its own.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	chain := append([]Middleware(nil), c.Middleware...)
+	chain = append(chain,
+		LoggingMiddleware(c.Logger),
+		HeaderMiddleware(http.Header{"User-Agent": {c.UserAgent}}),
+		c.authRetryMiddleware(),
+		AuthMiddleware(c.Authorizer),
+		RateLimitMiddleware(c.RateLimiter),
+	)
+
+	return Chain(chain...)(c.HTTPClient.Do)(req.WithContext(ctx))
+}
+
+// authRetryMiddleware replays a request once after a 401 response if the
+// authorizer implements auth.MissingAuthorizationHandler and refreshes its
+// credentials. The replay is authorized and rate limited again by the rest
+// of the chain.
+func (c *Client) authRetryMiddleware() Middleware {
+	return func(next RoundTripperFunc) RoundTripperFunc {
+		handler, ok := c.Authorizer.(auth.MissingAuthorizationHandler)
+		if !ok {
+			return next
+		}
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			if err != nil || resp.StatusCode != http.StatusUnauthorized {
+				return resp, err
+			}
+
+			retry, ok := c.prepareAuthRetry(handler, req, resp)
+			if !ok {
+				return resp, nil
+			}
+			resp.Body.Close()
+			c.Logger.Debug("Retrying %s %s with refreshed authorization", req.Method, req.URL.String())
+			return next(retry)
+		}
+	}
+}
+
+// prepareAuthRetry refreshes the authorizer after a 401 response and returns
+// a copy of the request to replay. It returns false if the authorizer cannot
+// refresh or the request body cannot be rewound.
+func (c *Client) prepareAuthRetry(handler auth.MissingAuthorizationHandler, req *http.Request, resp *http.Response) (*http.Request, bool) {
+	// Requests with a body can only be replayed if the body can be recreated
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		c.Logger.Debug("Not retrying %s %s: request body cannot be rewound", req.Method, req.URL.String())
+		return nil, false
+	}
+
+	// Tell the authorizer which token was rejected, so it does not refresh
+	// again if another request already replaced it
+	ctx := req.Context()
+	handlerCtx := ctx
+	if resp.Request != nil {
+		handlerCtx = auth.WithRejectedAuthorization(ctx, resp.Request.Header.Get("Authorization"))
+	}
+	if !handler.HandleMissingAuthorization(handlerCtx) {
+		return nil, false
+	}
+
+	retry := req.Clone(ctx)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			c.Logger.Error("Failed to rewind request body: %v", err)
+			return nil, false
+		}
+		retry.Body = body
+	}
+
+	return retry, true
+}
+
 // GetHTTPClient returns the underlying HTTP client
 func (c *Client) GetHTTPClient() *http.Client {
 	return c.HTTPClient
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package core
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/authorizers"
+)
+
+// rotatingTokenServer accepts only the most recently issued access token
+type rotatingTokenServer struct {
+	mu      sync.Mutex
+	current string
+	issued  int
+	bodies  []string
+}
+
+func (s *rotatingTokenServer) rotate() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.issued++
+	s.current = "token-" + strings.Repeat("x", s.issued)
+	return s.current
+}
+
+func (s *rotatingTokenServer) token() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.current
+}
+
+func (s *rotatingTokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	s.bodies = append(s.bodies, string(body))
+
+	if r.Header.Get("Authorization") != "Bearer "+s.current {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, `{"errors":[{"code":"AuthenticationFailed","message":"Token is not active"}]}`)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, `{}`)
+}
+
+func TestClientDoRefreshesOnUnauthorized(t *testing.T) {
+	tokens := &rotatingTokenServer{}
+	stale := tokens.rotate()
+	server := httptest.NewServer(tokens)
+	defer server.Close()
+
+	// The server revokes the current token before it expires
+	tokens.rotate()
+
+	refreshes := 0
+	authorizer := authorizers.NewRefreshableTokenAuthorizer(stale, "refresh-token", 3600,
+		func(ctx context.Context, refreshToken string) (string, string, time.Time, error) {
+			refreshes++
+			return tokens.token(), "refresh-token-2", time.Now().Add(time.Hour), nil
+		})
+
+	client := NewClient(WithBaseURL(server.URL), WithAuthorizer(authorizers.ToCore(authorizer)))
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/submit", strings.NewReader(`{"label":"retry"}`))
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if refreshes != 1 {
+		t.Errorf("Refresh called %d times, want 1", refreshes)
+	}
+	if len(tokens.bodies) != 2 {
+		t.Fatalf("Server received %d requests, want 2", len(tokens.bodies))
+	}
+	if tokens.bodies[1] != `{"label":"retry"}` {
+		t.Errorf("Replayed body = %q, want original body", tokens.bodies[1])
+	}
+	if authorizer.RefreshToken != "refresh-token-2" {
+		t.Errorf("RefreshToken = %q, want rotated refresh token", authorizer.RefreshToken)
+	}
+}
+
+func TestClientDoRetriesUnauthorizedOnce(t *testing.T) {
+	tokens := &rotatingTokenServer{}
+	tokens.rotate()
+	server := httptest.NewServer(tokens)
+	defer server.Close()
+
+	refreshes := 0
+	authorizer := authorizers.NewRefreshableTokenAuthorizer("revoked", "refresh-token", 3600,
+		func(ctx context.Context, refreshToken string) (string, string, time.Time, error) {
+			refreshes++
+			return "still-revoked", "", time.Now().Add(time.Hour), nil
+		})
+
+	client := NewClient(WithAuthorizer(authorizers.ToCore(authorizer)))
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/resource", nil)
+	_, err := client.Do(context.Background(), req)
+	if !IsUnauthorized(err) {
+		t.Fatalf("Do() error = %v, want unauthorized error", err)
+	}
+	if refreshes != 1 {
+		t.Errorf("Refresh called %d times, want 1", refreshes)
+	}
+	if len(tokens.bodies) != 2 {
+		t.Errorf("Server received %d requests, want 2", len(tokens.bodies))
+	}
+}
+
+func TestClientDoSkipsRefreshForReplacedToken(t *testing.T) {
+	tokens := &rotatingTokenServer{}
+	stale := tokens.rotate()
+
+	refreshes := 0
+	authorizer := authorizers.NewRefreshableTokenAuthorizer(stale, "refresh-token", 3600,
+		func(ctx context.Context, refreshToken string) (string, string, time.Time, error) {
+			refreshes++
+			return tokens.rotate(), "refresh-token-2", time.Now().Add(time.Hour), nil
+		})
+
+	// Another request refreshes the token while this one is in flight
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer "+stale {
+			authorizer.HandleMissingAuthorization(context.Background())
+		}
+		tokens.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithAuthorizer(authorizers.ToCore(authorizer)))
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/resource", nil)
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if refreshes != 1 {
+		t.Errorf("Refresh called %d times, want 1", refreshes)
+	}
+}
+
+func TestClientDoDoesNotRetryWithoutRefresh(t *testing.T) {
+	tokens := &rotatingTokenServer{}
+	tokens.rotate()
+	server := httptest.NewServer(tokens)
+	defer server.Close()
+
+	client := NewClient(WithAuthorizer(authorizers.StaticTokenCoreAuthorizer("revoked")))
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/resource", nil)
+	_, err := client.Do(context.Background(), req)
+	if !IsUnauthorized(err) {
+		t.Fatalf("Do() error = %v, want unauthorized error", err)
+	}
+	if len(tokens.bodies) != 1 {
+		t.Errorf("Server received %d requests, want 1", len(tokens.bodies))
+	}
+}
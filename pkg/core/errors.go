@@ -4,17 +4,111 @@ package core
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 )
 
+// Sentinel errors shared by every service package. Service errors match them
+// with errors.Is based on their HTTP status and Globus error code, so generic
+// code can classify a failure without knowing which service returned it:
+//
+//	if errors.Is(err, core.ErrRateLimited) {
+//		// Back off and retry
+//	}
+var (
+	// ErrBadRequest matches 400 responses
+	ErrBadRequest = errors.New("bad request")
+
+	// ErrAuthorizationRequired matches 401 responses: the token is missing,
+	// expired or revoked
+	ErrAuthorizationRequired = errors.New("authorization required")
+
+	// ErrForbidden matches 403 responses
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrConsentRequired matches errors asking the user to consent to more
+	// scopes, such as a collection's data_access scope
+	ErrConsentRequired = errors.New("consent required")
+
+	// ErrNotFound matches 404 responses
+	ErrNotFound = errors.New("not found")
+
+	// ErrConflict matches 409 responses
+	ErrConflict = errors.New("conflict")
+
+	// ErrRateLimited matches 429 responses
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrServiceUnavailable matches 5xx responses
+	ErrServiceUnavailable = errors.New("service unavailable")
+)
+
+// APIError is implemented by the errors every service package returns for
+// failed requests. Use errors.As to get at the details of any service's error:
+//
+//	var apiErr core.APIError
+//	if errors.As(err, &apiErr) {
+//		log.Printf("%d %s (request %s)", apiErr.HTTPStatus(), apiErr.ErrorCode(), apiErr.ErrorRequestID())
+//	}
+type APIError interface {
+	error
+
+	// HTTPStatus returns the HTTP status code of the response
+	HTTPStatus() int
+
+	// ErrorCode returns the Globus error code, if the response had one
+	ErrorCode() string
+
+	// ErrorRequestID returns the Globus request ID, if the response had one
+	ErrorRequestID() string
+
+	// ResponseBody returns the raw body of the response
+	ResponseBody() []byte
+}
+
+// consentRequiredCodes are the error codes services use to ask for consent
+var consentRequiredCodes = []string{"ConsentRequired", "consent_required"}
+
+// MatchesError reports whether an error with the given HTTP status and Globus
+// error code matches target, one of the sentinel errors in this package.
+// Service error types call it from their Is methods.
+func MatchesError(statusCode int, code string, target error) bool {
+	switch target {
+	case ErrConsentRequired:
+		for _, consentCode := range consentRequiredCodes {
+			if strings.EqualFold(code, consentCode) {
+				return true
+			}
+		}
+		return false
+	case ErrBadRequest:
+		return statusCode == http.StatusBadRequest
+	case ErrAuthorizationRequired:
+		return statusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return statusCode == http.StatusForbidden
+	case ErrNotFound:
+		return statusCode == http.StatusNotFound
+	case ErrConflict:
+		return statusCode == http.StatusConflict
+	case ErrRateLimited:
+		return statusCode == http.StatusTooManyRequests
+	case ErrServiceUnavailable:
+		return statusCode >= 500 && statusCode < 600
+	}
+	return false
+}
+
 // Error represents an API error
 type Error struct {
 	Code       string `json:"code"`
 	Message    string `json:"message"`
 	Resource   string `json:"resource,omitempty"`
 	Field      string `json:"field,omitempty"`
+	RequestID  string `json:"request_id,omitempty"`
 	StatusCode int    `json:"-"`
 	RawBody    []byte `json:"-"`
 }
@@ -24,12 +118,39 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("%s: %s (status: %d)", e.Code, e.Message, e.StatusCode)
 }
 
+// ResponseBody returns the raw body of the response that caused the error
+func (e *Error) ResponseBody() []byte {
+	return e.RawBody
+}
+
+// HTTPStatus returns the HTTP status code of the response that caused the error
+func (e *Error) HTTPStatus() int {
+	return e.StatusCode
+}
+
+// ErrorCode returns the Globus error code
+func (e *Error) ErrorCode() string {
+	return e.Code
+}
+
+// ErrorRequestID returns the Globus request ID
+func (e *Error) ErrorRequestID() string {
+	return e.RequestID
+}
+
+// Is reports whether the error matches one of the sentinel errors in this package
+func (e *Error) Is(target error) bool {
+	return MatchesError(e.StatusCode, e.Code, target)
+}
+
 // ErrorResponse represents the error response from the API
 type ErrorResponse struct {
 	Errors []Error `json:"errors"`
 }
 
-// NewAPIError creates a new Error from an API response
+// NewAPIError creates a new Error from an API response. It understands the
+// {"errors": [...]} format, Transfer-style top-level code and message fields,
+// and OAuth-style error and error_description fields.
 func NewAPIError(resp *http.Response) error {
 	// Read and capture the response body
 	defer resp.Body.Close()
@@ -38,46 +159,81 @@ func NewAPIError(resp *http.Response) error {
 		return fmt.Errorf("failed to read error response body: %w", err)
 	}
 
+	requestID := resp.Header.Get("X-Request-Id")
+
 	// Try to parse as ErrorResponse
 	errorResponse := &ErrorResponse{}
 	err = json.Unmarshal(body, errorResponse)
-	if err != nil || len(errorResponse.Errors) == 0 {
-		// Failed to parse or no errors, return a generic error
-		return &Error{
-			Code:       "unknown_error",
-			Message:    fmt.Sprintf("Request failed with status code %d", resp.StatusCode),
-			StatusCode: resp.StatusCode,
-			RawBody:    body,
+	if err == nil && len(errorResponse.Errors) > 0 {
+		// Return the first error
+		apiError := errorResponse.Errors[0]
+		apiError.StatusCode = resp.StatusCode
+		apiError.RawBody = body
+		if apiError.RequestID == "" {
+			apiError.RequestID = requestID
+		}
+		return &apiError
+	}
+
+	// Try a single error object
+	var single struct {
+		Code             string `json:"code"`
+		Message          string `json:"message"`
+		Resource         string `json:"resource"`
+		RequestID        string `json:"request_id"`
+		OAuthError       string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if json.Unmarshal(body, &single) == nil {
+		if single.Code == "" {
+			single.Code, single.Message = single.OAuthError, single.ErrorDescription
+		}
+		if single.Code != "" {
+			if single.RequestID == "" {
+				single.RequestID = requestID
+			}
+			return &Error{
+				Code:       single.Code,
+				Message:    single.Message,
+				Resource:   single.Resource,
+				RequestID:  single.RequestID,
+				StatusCode: resp.StatusCode,
+				RawBody:    body,
+			}
 		}
 	}
 
-	// Return the first error
-	apiError := errorResponse.Errors[0]
-	apiError.StatusCode = resp.StatusCode
-	apiError.RawBody = body
-	return &apiError
+	// Failed to parse or no errors, return a generic error
+	return &Error{
+		Code:       "unknown_error",
+		Message:    fmt.Sprintf("Request failed with status code %d", resp.StatusCode),
+		RequestID:  requestID,
+		StatusCode: resp.StatusCode,
+		RawBody:    body,
+	}
 }
 
-// IsUnauthorized checks if the error is an unauthorized error
+// IsUnauthorized checks if the error is an unauthorized error from any service
 func IsUnauthorized(err error) bool {
-	if apiErr, ok := err.(*Error); ok {
-		return apiErr.StatusCode == http.StatusUnauthorized
-	}
-	return false
+	return errors.Is(err, ErrAuthorizationRequired)
 }
 
-// IsNotFound checks if the error is a not found error
+// IsNotFound checks if the error is a not found error from any service
 func IsNotFound(err error) bool {
-	if apiErr, ok := err.(*Error); ok {
-		return apiErr.StatusCode == http.StatusNotFound
-	}
-	return false
+	return errors.Is(err, ErrNotFound)
 }
 
-// IsForbidden checks if the error is a forbidden error
+// IsForbidden checks if the error is a forbidden error from any service
 func IsForbidden(err error) bool {
-	if apiErr, ok := err.(*Error); ok {
-		return apiErr.StatusCode == http.StatusForbidden
-	}
-	return false
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsRateLimited checks if the error is a rate limit error from any service
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsConsentRequired checks if the error asks for consent to more scopes
+func IsConsentRequired(err error) bool {
+	return errors.Is(err, ErrConsentRequired)
 }
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newErrorResponse(status int, body string, requestID string) *http.Response {
+	header := http.Header{}
+	if requestID != "" {
+		header.Set("X-Request-Id", requestID)
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		header    string
+		code      string
+		requestID string
+		matches   []error
+		misses    []error
+	}{
+		{
+			name:      "errors list",
+			status:    http.StatusNotFound,
+			body:      `{"errors": [{"code": "NOT_FOUND", "message": "no such group"}]}`,
+			header:    "hdr-1",
+			code:      "NOT_FOUND",
+			requestID: "hdr-1",
+			matches:   []error{ErrNotFound},
+			misses:    []error{ErrForbidden, ErrServiceUnavailable},
+		},
+		{
+			name:      "transfer style",
+			status:    http.StatusForbidden,
+			body:      `{"code": "ConsentRequired", "message": "Missing consent", "request_id": "req-1"}`,
+			header:    "hdr-1",
+			code:      "ConsentRequired",
+			requestID: "req-1",
+			matches:   []error{ErrForbidden, ErrConsentRequired},
+			misses:    []error{ErrNotFound},
+		},
+		{
+			name:    "oauth style",
+			status:  http.StatusUnauthorized,
+			body:    `{"error": "invalid_token", "error_description": "Token expired"}`,
+			code:    "invalid_token",
+			matches: []error{ErrAuthorizationRequired},
+			misses:  []error{ErrConsentRequired},
+		},
+		{
+			name:    "rate limited without body",
+			status:  http.StatusTooManyRequests,
+			code:    "unknown_error",
+			matches: []error{ErrRateLimited},
+		},
+		{
+			name:    "server error",
+			status:  http.StatusBadGateway,
+			body:    `<html>Bad Gateway</html>`,
+			code:    "unknown_error",
+			matches: []error{ErrServiceUnavailable},
+			misses:  []error{ErrBadRequest},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fmt.Errorf("request failed: %w", NewAPIError(newErrorResponse(tt.status, tt.body, tt.header)))
+
+			var apiErr APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("errors.As(%v, APIError) = false", err)
+			}
+			if apiErr.HTTPStatus() != tt.status || apiErr.ErrorCode() != tt.code || apiErr.ErrorRequestID() != tt.requestID {
+				t.Errorf("APIError = %d %q %q, want %d %q %q", apiErr.HTTPStatus(), apiErr.ErrorCode(),
+					apiErr.ErrorRequestID(), tt.status, tt.code, tt.requestID)
+			}
+			if string(apiErr.ResponseBody()) != tt.body {
+				t.Errorf("ResponseBody() = %q, want %q", apiErr.ResponseBody(), tt.body)
+			}
+			for _, target := range tt.matches {
+				if !errors.Is(err, target) {
+					t.Errorf("errors.Is(err, %v) = false", target)
+				}
+			}
+			for _, target := range tt.misses {
+				if errors.Is(err, target) {
+					t.Errorf("errors.Is(err, %v) = true", target)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", &Error{Code: "consent_required", StatusCode: http.StatusForbidden})
+	if !IsForbidden(err) || !IsConsentRequired(err) {
+		t.Error("IsForbidden() and IsConsentRequired() should match a wrapped consent error")
+	}
+	if IsNotFound(err) || IsUnauthorized(err) || IsRateLimited(err) {
+		t.Error("Helpers should not match other statuses")
+	}
+	if IsNotFound(errors.New("not found")) {
+		t.Error("IsNotFound() should not match an unrelated error")
+	}
+}
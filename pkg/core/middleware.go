@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package core
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/auth"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/interfaces"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/ratelimit"
+)
+
+// RoundTripperFunc sends a single HTTP request. The request context is
+// available through req.Context().
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the next step of a request chain. A middleware may change
+// the request, inspect or replace the response, or stop the chain by
+// returning without calling next. Middleware that changes the request should
+// change a copy made with req.Clone.
+type Middleware func(next RoundTripperFunc) RoundTripperFunc
+
+// Chain combines middleware into one. The first middleware is the outermost
+// and sees the request first and the response last.
+func Chain(middleware ...Middleware) Middleware {
+	return func(next RoundTripperFunc) RoundTripperFunc {
+		for i := len(middleware) - 1; i >= 0; i-- {
+			if middleware[i] != nil {
+				next = middleware[i](next)
+			}
+		}
+		return next
+	}
+}
+
+// NewRoundTripper returns an http.RoundTripper that passes requests through
+// the middleware before sending them with base, so the same middleware can be
+// used with a plain http.Client. A nil base uses http.DefaultTransport.
+func NewRoundTripper(base http.RoundTripper, middleware ...Middleware) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return Chain(middleware...)(base.RoundTrip)
+}
+
+// WithMiddleware appends middleware to the client's chain. Client middleware
+// runs in the order added and wraps the client's own steps: logging, the user
+// agent, the replay after a 401, authorization and rate limiting. It sees a
+// request before the client sets its headers and a response after any 401
+// replay, and each request it passes on, such as a retry from
+// RetryMiddleware, is authorized and rate limited again.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.Middleware = append(c.Middleware, middleware...)
+	}
+}
+
+// HeaderMiddleware sets the given headers on every request, replacing any
+// existing values
+func HeaderMiddleware(header http.Header) Middleware {
+	return func(next RoundTripperFunc) RoundTripperFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			for key, values := range header {
+				req.Header.Del(key)
+				for _, value := range values {
+					req.Header.Add(key, value)
+				}
+			}
+			return next(req)
+		}
+	}
+}
+
+// AuthMiddleware sets the Authorization header from the authorizer. A nil
+// authorizer leaves requests unchanged.
+func AuthMiddleware(authorizer auth.Authorizer) Middleware {
+	return func(next RoundTripperFunc) RoundTripperFunc {
+		if authorizer == nil {
+			return next
+		}
+		return func(req *http.Request) (*http.Response, error) {
+			header, err := authorizer.GetAuthorizationHeader(req.Context())
+			if err != nil {
+				return nil, err
+			}
+			if header != "" {
+				req = req.Clone(req.Context())
+				req.Header.Set("Authorization", header)
+			}
+			return next(req)
+		}
+	}
+}
+
+// RateLimitMiddleware waits for the limiter before each request and updates
+// it from the rate limit headers of the response. A nil limiter leaves
+// requests unchanged.
+func RateLimitMiddleware(limiter ratelimit.RateLimiter) Middleware {
+	return func(next RoundTripperFunc) RoundTripperFunc {
+		if limiter == nil {
+			return next
+		}
+		return func(req *http.Request) (*http.Response, error) {
+			if err := limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+			resp, err := next(req)
+			if err == nil {
+				ratelimit.UpdateRateLimiterFromResponse(limiter, resp)
+			}
+			return resp, err
+		}
+	}
+}
+
+// RetryMiddleware retries requests that fail with a transport error or a
+// 429, 502, 503 or 504 response, waiting as long as the Retry-After header
+// asks or otherwise as long as the backoff strategy says. A nil strategy uses
+// ratelimit.DefaultBackoff. Requests whose body cannot be rewound with
+// GetBody are not retried.
+//
+// Every method is retried, so only use it with services where replaying a
+// request is safe, such as Transfer submissions that carry a submission ID.
+func RetryMiddleware(backoff ratelimit.BackoffStrategy) Middleware {
+	if backoff == nil {
+		backoff = ratelimit.DefaultBackoff()
+	}
+	// Backoff strategies are not safe for concurrent use
+	var mu sync.Mutex
+
+	return func(next RoundTripperFunc) RoundTripperFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			for attempt := 1; ; attempt++ {
+				resp, err := next(req)
+				if attempt > backoff.MaxAttempts() || !shouldRetry(resp, err) || ctx.Err() != nil {
+					return resp, err
+				}
+				if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+					return resp, err
+				}
+
+				if observe, ok := ctx.Value(retryObserverKey{}).(func(int)); ok {
+					observe(attempt)
+				}
+
+				mu.Lock()
+				delay := backoff.NextBackoff(attempt)
+				mu.Unlock()
+				if resp != nil {
+					if info, ok := ratelimit.ExtractRateLimitInfo(resp); ok && info.Retry > 0 {
+						delay = time.Duration(info.Retry) * time.Second
+					}
+					// Drain the body so the connection can be reused
+					_, _ = io.Copy(io.Discard, resp.Body)
+					resp.Body.Close()
+				}
+
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-ctx.Done():
+					timer.Stop()
+					return nil, ctx.Err()
+				}
+
+				retry := req.Clone(ctx)
+				if req.GetBody != nil {
+					body, err := req.GetBody()
+					if err != nil {
+						return nil, err
+					}
+					retry.Body = body
+				}
+				req = retry
+			}
+		}
+	}
+}
+
+// retryObserverKey is the context key for the WithRetryObserver callback
+type retryObserverKey struct{}
+
+// WithRetryObserver returns a context in which RetryMiddleware calls observe
+// with the retry number, starting at 1, before replaying a request. It lets
+// middleware placed before RetryMiddleware see the retries of a request.
+func WithRetryObserver(ctx context.Context, observe func(retry int)) context.Context {
+	return context.WithValue(ctx, retryObserverKey{}, observe)
+}
+
+// shouldRetry reports whether RetryMiddleware should replay a request
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// LoggingMiddleware logs each request at debug level and failed requests at
+// error level. A nil logger leaves requests unchanged.
+func LoggingMiddleware(logger interfaces.Logger) Middleware {
+	return func(next RoundTripperFunc) RoundTripperFunc {
+		if logger == nil {
+			return next
+		}
+		return func(req *http.Request) (*http.Response, error) {
+			logger.Debug("Making request to %s %s", req.Method, req.URL.String())
+			start := time.Now()
+			resp, err := next(req)
+			if err != nil {
+				logger.Error("Request failed: %v", err)
+				return nil, err
+			}
+			logger.Debug("Received %d from %s %s in %s", resp.StatusCode, req.Method, req.URL.String(), time.Since(start))
+			return resp, nil
+		}
+	}
+}
+
+// RequestMetrics describes a completed request for MetricsMiddleware
+type RequestMetrics struct {
+	Method     string
+	Host       string
+	Path       string
+	StatusCode int // zero if the request failed without a response
+	Duration   time.Duration
+	Err        error
+}
+
+// MetricsMiddleware calls observe after every request with its method,
+// target, status and duration
+func MetricsMiddleware(observe func(RequestMetrics)) Middleware {
+	return func(next RoundTripperFunc) RoundTripperFunc {
+		if observe == nil {
+			return next
+		}
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			metrics := RequestMetrics{
+				Method:   req.Method,
+				Host:     req.URL.Host,
+				Path:     req.URL.Path,
+				Duration: time.Since(start),
+				Err:      err,
+			}
+			if resp != nil {
+				metrics.StatusCode = resp.StatusCode
+			}
+			observe(metrics)
+			return resp, err
+		}
+	}
+}
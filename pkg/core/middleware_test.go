@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package core
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/authorizers"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/ratelimit"
+)
+
+// recordingMiddleware appends name to calls before and after the request
+func recordingMiddleware(name string, calls *[]string) Middleware {
+	return func(next RoundTripperFunc) RoundTripperFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			*calls = append(*calls, name)
+			resp, err := next(req)
+			*calls = append(*calls, "/"+name)
+			return resp, err
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+	final := func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, "send")
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}
+
+	rt := Chain(recordingMiddleware("a", &calls), nil, recordingMiddleware("b", &calls))(final)
+	req := httptest.NewRequest(http.MethodGet, "https://example.org/", nil)
+	if _, err := rt(req); err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+
+	want := []string{"a", "b", "send", "/b", "/a"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("Calls = %v, want %v", calls, want)
+	}
+}
+
+func TestClientMiddleware(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The client middleware wraps the client's own steps, so it sees the
+	// request before the client sets its headers
+	var seenAuth string
+	audit := func(next RoundTripperFunc) RoundTripperFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			seenAuth = req.Header.Get("Authorization")
+			return next(req)
+		}
+	}
+	var metrics []RequestMetrics
+	client := NewClient(
+		WithAuthorizer(authorizers.ToCore(authorizers.NewStaticTokenAuthorizer("token"))),
+		WithMiddleware(audit, HeaderMiddleware(http.Header{"X-Team": {"data"}})),
+		WithMiddleware(MetricsMiddleware(func(m RequestMetrics) { metrics = append(metrics, m) })),
+	)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/tasks", nil)
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if seenAuth != "" {
+		t.Errorf("Middleware saw Authorization %q", seenAuth)
+	}
+	if received.Get("X-Team") != "data" || received.Get("User-Agent") != client.UserAgent ||
+		received.Get("Authorization") != "Bearer token" {
+		t.Errorf("Server received headers %v", received)
+	}
+	if len(metrics) != 1 || metrics[0].Path != "/tasks" || metrics[0].StatusCode != http.StatusOK {
+		t.Errorf("Metrics = %+v", metrics)
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Error("Do() modified the caller's request headers")
+	}
+}
+
+func TestRetryMiddleware(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("Attempt %d sent Authorization %q", len(bodies)+1, r.Header.Get("Authorization"))
+		}
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// Every attempt is authorized and waits for the rate limiter
+	backoff := ratelimit.NewExponentialBackoff(time.Millisecond, 10*time.Millisecond, 2, 3)
+	limiter := ratelimit.NewNoopRateLimiter()
+	authorizer := authorizers.ToCore(authorizers.NewStaticTokenAuthorizer("token"))
+	client := NewClient(WithAuthorizer(authorizer), WithRateLimiter(limiter), WithMiddleware(RetryMiddleware(backoff)))
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/submit", strings.NewReader(`{"label":"retry"}`))
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if len(bodies) != 3 || bodies[2] != `{"label":"retry"}` {
+		t.Errorf("Server received %q", bodies)
+	}
+	if waits := limiter.GetStats().TotalRequests; waits != 3 {
+		t.Errorf("Rate limiter waited %d times, want 3", waits)
+	}
+
+	// Giving up returns the last response as an API error
+	bodies = nil
+	backoff = ratelimit.NewExponentialBackoff(time.Millisecond, 10*time.Millisecond, 2, 1)
+	client = NewClient(WithAuthorizer(authorizer), WithMiddleware(RetryMiddleware(backoff)))
+	req, _ = http.NewRequest(http.MethodGet, server.URL+"/tasks", nil)
+	if _, err := client.Do(context.Background(), req); !errors.Is(err, ErrServiceUnavailable) {
+		t.Errorf("Do() error = %v, want ErrServiceUnavailable", err)
+	}
+	if len(bodies) != 2 {
+		t.Errorf("Server received %d requests, want 2", len(bodies))
+	}
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package core
+
+import (
+	"context"
+)
+
+// PageRequest identifies the page a PageFunc should fetch. Services that page
+// by offset and limit use Offset; services that page by marker or page token
+// use Marker. Offset counts the items returned by earlier pages, so callers
+// that start from an offset of their own add it.
+type PageRequest struct {
+	// Offset is the number of items returned by earlier pages
+	Offset int
+
+	// Marker is the marker or page token returned with the previous page,
+	// empty for the first page
+	Marker string
+}
+
+// Page is one page of results returned by a PageFunc
+type Page[T any] struct {
+	// Items are the results on the page
+	Items []T
+
+	// HasNextPage reports whether the service has more results. Services
+	// that report has_next_page or had_more set it directly; others derive
+	// it from a total or from the presence of a next marker.
+	HasNextPage bool
+
+	// NextMarker is the marker or page token that requests the next page,
+	// for services that page by marker
+	NextMarker string
+}
+
+// PageFunc fetches one page of a list
+type PageFunc[T any] func(ctx context.Context, request PageRequest) (*Page[T], error)
+
+// Pager iterates over every item of a paged list, fetching pages as needed.
+// It works with offset/limit, marker and page-token paging: the next request
+// carries the offset after the items seen so far and the marker returned
+// with the last page. Paging stops when a page reports no next page, is
+// empty, or repeats the previous marker.
+//
+//	pager := client.AllTasks(ctx, nil)
+//	for pager.Next() {
+//		task := pager.Value()
+//		// ...
+//	}
+//	if err := pager.Err(); err != nil {
+//		// ...
+//	}
+//
+// A Pager is not safe for concurrent use and can be iterated once.
+type Pager[T any] struct {
+	ctx      context.Context
+	fetch    PageFunc[T]
+	request  PageRequest
+	items    []T
+	position int
+	value    T
+	more     bool
+	err      error
+}
+
+// NewPager creates a Pager that fetches pages with fetch. No request is made
+// until the first call to Next.
+func NewPager[T any](ctx context.Context, fetch PageFunc[T]) *Pager[T] {
+	return &Pager[T]{
+		ctx:   ctx,
+		fetch: fetch,
+		more:  true,
+	}
+}
+
+// NewSlicePager creates a Pager over results that were fetched in one
+// request, for list APIs that do not page
+func NewSlicePager[T any](ctx context.Context, fetch func(ctx context.Context) ([]T, error)) *Pager[T] {
+	return NewPager(ctx, func(ctx context.Context, _ PageRequest) (*Page[T], error) {
+		items, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &Page[T]{Items: items}, nil
+	})
+}
+
+// Next advances to the next item, fetching the next page when the current
+// one is used up. It returns false when there are no more items or an error
+// occurred.
+func (p *Pager[T]) Next() bool {
+	for p.position >= len(p.items) {
+		if !p.more || p.err != nil {
+			return false
+		}
+		if err := p.ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+
+		page, err := p.fetch(p.ctx, p.request)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		if page == nil {
+			page = &Page[T]{}
+		}
+
+		p.items = page.Items
+		p.position = 0
+		p.more = page.HasNextPage && len(page.Items) > 0
+		if p.request.Marker != "" && (page.NextMarker == "" || page.NextMarker == p.request.Marker) {
+			// Without a new marker the next request would repeat a page
+			p.more = false
+		}
+		p.request = PageRequest{
+			Offset: p.request.Offset + len(page.Items),
+			Marker: page.NextMarker,
+		}
+	}
+
+	p.value = p.items[p.position]
+	p.position++
+	return true
+}
+
+// Value returns the current item
+func (p *Pager[T]) Value() T {
+	return p.value
+}
+
+// Err returns the error that stopped the iteration, if any
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Collect returns the remaining items
+func (p *Pager[T]) Collect() ([]T, error) {
+	var items []T
+	for p.Next() {
+		items = append(items, p.Value())
+	}
+	if err := p.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+
+//go:build go1.23
+
+package core
+
+import (
+	"iter"
+)
+
+// Seq returns an iterator over the remaining items for use with range. An
+// error stops the iteration after it is yielded with the zero item.
+//
+//	for task, err := range client.AllTasks(ctx, nil).Seq() {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (p *Pager[T]) Seq() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.Next() {
+			if !yield(p.Value(), nil) {
+				return
+			}
+		}
+		if err := p.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+
+//go:build go1.23
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestPagerSeq(t *testing.T) {
+	fail := errors.New("boom")
+	pager := NewPager(context.Background(), func(ctx context.Context, request PageRequest) (*Page[int], error) {
+		if request.Offset >= 4 {
+			return nil, fail
+		}
+		return &Page[int]{Items: []int{request.Offset, request.Offset + 1}, HasNextPage: true}, nil
+	})
+
+	var got []int
+	var gotErr error
+	for item, err := range pager.Seq() {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		got = append(got, item)
+	}
+	if len(got) != 4 || got[3] != 3 || !errors.Is(gotErr, fail) {
+		t.Errorf("Seq() = %v, %v", got, gotErr)
+	}
+
+	// Breaking out of the loop keeps the remaining items
+	slice := NewSlicePager(context.Background(), func(ctx context.Context) ([]string, error) {
+		return []string{"a", "b", "c"}, nil
+	})
+	for item := range slice.Seq() {
+		if item == "a" {
+			break
+		}
+	}
+	if rest, err := slice.Collect(); err != nil || len(rest) != 2 {
+		t.Errorf("Collect() after break = %v, %v", rest, err)
+	}
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package core
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestPagerOffset(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	var requests []PageRequest
+	pager := NewPager(context.Background(), func(ctx context.Context, request PageRequest) (*Page[int], error) {
+		requests = append(requests, request)
+		end := request.Offset + 2
+		if end > len(items) {
+			end = len(items)
+		}
+		return &Page[int]{Items: items[request.Offset:end], HasNextPage: end < len(items)}, nil
+	})
+
+	got, err := pager.Collect()
+	if err != nil || !reflect.DeepEqual(got, items) {
+		t.Fatalf("Collect() = %v, %v, want %v", got, err, items)
+	}
+	want := []PageRequest{{Offset: 0}, {Offset: 2}, {Offset: 4}}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("Requests = %+v, want %+v", requests, want)
+	}
+	if pager.Next() {
+		t.Error("Next() = true after the last page")
+	}
+}
+
+func TestPagerMarker(t *testing.T) {
+	pages := map[string]*Page[string]{
+		"":   {Items: []string{"a", "b"}, HasNextPage: true, NextMarker: "m1"},
+		"m1": {Items: []string{"c"}, HasNextPage: true, NextMarker: "m2"},
+		"m2": {Items: []string{"d"}, HasNextPage: true, NextMarker: "m2"},
+	}
+	var markers []string
+	pager := NewPager(context.Background(), func(ctx context.Context, request PageRequest) (*Page[string], error) {
+		markers = append(markers, request.Marker)
+		return pages[request.Marker], nil
+	})
+
+	var got []string
+	for pager.Next() {
+		got = append(got, pager.Value())
+	}
+	if pager.Err() != nil || !reflect.DeepEqual(got, []string{"a", "b", "c", "d"}) {
+		t.Fatalf("Items = %v, %v", got, pager.Err())
+	}
+	// The repeated marker on the last page stops paging
+	if !reflect.DeepEqual(markers, []string{"", "m1", "m2"}) {
+		t.Errorf("Markers = %v", markers)
+	}
+}
+
+func TestPagerError(t *testing.T) {
+	fail := errors.New("boom")
+	calls := 0
+	pager := NewPager(context.Background(), func(ctx context.Context, request PageRequest) (*Page[string], error) {
+		calls++
+		if request.Offset > 0 {
+			return nil, fail
+		}
+		return &Page[string]{Items: []string{strconv.Itoa(calls)}, HasNextPage: true}, nil
+	})
+
+	if !pager.Next() || pager.Value() != "1" {
+		t.Fatalf("Next() did not return the first item")
+	}
+	if pager.Next() || !errors.Is(pager.Err(), fail) {
+		t.Errorf("Next() after a failed fetch: Err() = %v", pager.Err())
+	}
+	if pager.Next() || calls != 2 {
+		t.Errorf("Next() fetched again after an error, calls = %d", calls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	canceled := NewSlicePager(ctx, func(ctx context.Context) ([]string, error) { return []string{"x"}, nil })
+	if _, err := canceled.Collect(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Collect() with a canceled context = %v", err)
+	}
+}
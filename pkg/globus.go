@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+	coreauth "github.com/scttfrdmn/globus-go-sdk/pkg/core/auth"
 	"github.com/scttfrdmn/globus-go-sdk/pkg/core/config"
 	httppool "github.com/scttfrdmn/globus-go-sdk/pkg/core/http"
 	"github.com/scttfrdmn/globus-go-sdk/pkg/services/auth"
@@ -50,11 +51,38 @@ const (
 	TokensScope = auth.AuthScope
 )
 
+// Resource servers under which Globus Auth returns tokens for each service.
+// Token managers store tokens keyed by these names.
+const (
+	// AuthResourceServer is the resource server for the Auth service
+	AuthResourceServer = "auth.globus.org"
+
+	// GroupsResourceServer is the resource server for the Groups service
+	GroupsResourceServer = "groups.api.globus.org"
+
+	// TransferResourceServer is the resource server for the Transfer service
+	TransferResourceServer = "transfer.api.globus.org"
+
+	// SearchResourceServer is the resource server for the Search service
+	SearchResourceServer = "search.api.globus.org"
+
+	// FlowsResourceServer is the resource server for the Flows service
+	FlowsResourceServer = "flows.globus.org"
+
+	// ComputeResourceServer is the resource server for the Compute service
+	ComputeResourceServer = "funcx_service"
+
+	// TimersResourceServer is the resource server for the Timers service
+	TimersResourceServer = "524230d7-ea86-4a52-8312-86065a9e0417"
+)
+
 // SDKConfig holds configuration for all services
 type SDKConfig struct {
 	Config       *config.Config
 	ClientID     string
 	ClientSecret string
+
+	clientOptions []core.ClientOption
 }
 
 // NewAuthClient creates a new Auth client with the SDK configuration
@@ -82,14 +110,28 @@ func (c *SDKConfig) NewAuthClient() (*auth.Client, error) {
 		authClient.Client.HTTPClient = serviceClient
 	}
 
+	c.applyClientOptions(authClient.Client)
+
 	return authClient, nil
 }
 
 // NewGroupsClient creates a new Groups client with the SDK configuration
 func (c *SDKConfig) NewGroupsClient(accessToken string) (*groups.Client, error) {
 	// Create a simple static token authorizer directly
-	authorizer := &simpleAuthorizer{token: accessToken}
+	return c.newGroupsClient(&simpleAuthorizer{token: accessToken})
+}
 
+// NewGroupsClientWithManager creates a new Groups client that takes its tokens
+// from manager, refreshing them through the manager as needed
+func (c *SDKConfig) NewGroupsClientWithManager(manager *tokens.Manager) (*groups.Client, error) {
+	if manager == nil {
+		return nil, fmt.Errorf("token manager is required")
+	}
+	return c.newGroupsClient(manager.Authorizer(GroupsResourceServer))
+}
+
+// newGroupsClient creates a Groups client that uses authorizer
+func (c *SDKConfig) newGroupsClient(authorizer coreauth.Authorizer) (*groups.Client, error) {
 	// Create options for the groups client
 	options := []groups.Option{
 		groups.WithAuthorizer(authorizer),
@@ -110,15 +152,28 @@ func (c *SDKConfig) NewGroupsClient(accessToken string) (*groups.Client, error)
 		return nil, fmt.Errorf("error creating groups client: %w", err)
 	}
 
+	c.applyClientOptions(groupsClient.Client)
+
 	return groupsClient, nil
 }
 
 // NewTransferClient creates a new Transfer client with the SDK configuration
 func (c *SDKConfig) NewTransferClient(accessToken string) (*transfer.Client, error) {
 	// Create a simple static token authorizer directly
-	// using a type that satisfies the auth.Authorizer interface
-	authorizer := &simpleAuthorizer{token: accessToken}
+	return c.newTransferClient(&simpleAuthorizer{token: accessToken})
+}
+
+// NewTransferClientWithManager creates a new Transfer client that takes its tokens
+// from manager, refreshing them through the manager as needed
+func (c *SDKConfig) NewTransferClientWithManager(manager *tokens.Manager) (*transfer.Client, error) {
+	if manager == nil {
+		return nil, fmt.Errorf("token manager is required")
+	}
+	return c.newTransferClient(manager.Authorizer(TransferResourceServer))
+}
 
+// newTransferClient creates a Transfer client that uses authorizer
+func (c *SDKConfig) newTransferClient(authorizer coreauth.Authorizer) (*transfer.Client, error) {
 	// Create options for the transfer client
 	options := []transfer.Option{
 		transfer.WithAuthorizer(authorizer),
@@ -150,14 +205,30 @@ func (c *SDKConfig) NewTransferClient(accessToken string) (*transfer.Client, err
 		transferClient.Client.HTTPClient = serviceClient
 	}
 
+	c.applyClientOptions(transferClient.Client)
+
 	return transferClient, nil
 }
 
 // NewSearchClient creates a new Search client with the SDK configuration
 func (c *SDKConfig) NewSearchClient(accessToken string) (*search.Client, error) {
+	return c.newSearchClient(search.WithAccessToken(accessToken))
+}
+
+// NewSearchClientWithManager creates a new Search client that takes its tokens
+// from manager, refreshing them through the manager as needed
+func (c *SDKConfig) NewSearchClientWithManager(manager *tokens.Manager) (*search.Client, error) {
+	if manager == nil {
+		return nil, fmt.Errorf("token manager is required")
+	}
+	return c.newSearchClient(search.WithAuthorizer(manager.Authorizer(SearchResourceServer)))
+}
+
+// newSearchClient creates a Search client authorized by authOption
+func (c *SDKConfig) newSearchClient(authOption search.ClientOption) (*search.Client, error) {
 	// Create options for the search client
 	options := []search.ClientOption{
-		search.WithAccessToken(accessToken),
+		authOption,
 	}
 
 	// Add debugging if configured
@@ -186,14 +257,30 @@ func (c *SDKConfig) NewSearchClient(accessToken string) (*search.Client, error)
 		searchClient.Client.HTTPClient = serviceClient
 	}
 
+	c.applyClientOptions(searchClient.Client)
+
 	return searchClient, nil
 }
 
 // NewFlowsClient creates a new Flows client with the SDK configuration
 func (c *SDKConfig) NewFlowsClient(accessToken string) (*flows.Client, error) {
+	return c.newFlowsClient(flows.WithAccessToken(accessToken))
+}
+
+// NewFlowsClientWithManager creates a new Flows client that takes its tokens
+// from manager, refreshing them through the manager as needed
+func (c *SDKConfig) NewFlowsClientWithManager(manager *tokens.Manager) (*flows.Client, error) {
+	if manager == nil {
+		return nil, fmt.Errorf("token manager is required")
+	}
+	return c.newFlowsClient(flows.WithAuthorizer(manager.Authorizer(FlowsResourceServer)))
+}
+
+// newFlowsClient creates a Flows client authorized by authOption
+func (c *SDKConfig) newFlowsClient(authOption flows.ClientOption) (*flows.Client, error) {
 	// Create options for the flows client
 	options := []flows.ClientOption{
-		flows.WithAccessToken(accessToken),
+		authOption,
 	}
 
 	// Add debugging if configured
@@ -222,14 +309,30 @@ func (c *SDKConfig) NewFlowsClient(accessToken string) (*flows.Client, error) {
 		flowsClient.Client.HTTPClient = serviceClient
 	}
 
+	c.applyClientOptions(flowsClient.Client)
+
 	return flowsClient, nil
 }
 
 // NewComputeClient creates a new Compute client with the SDK configuration
 func (c *SDKConfig) NewComputeClient(accessToken string) (*compute.Client, error) {
+	return c.newComputeClient(compute.WithAccessToken(accessToken))
+}
+
+// NewComputeClientWithManager creates a new Compute client that takes its tokens
+// from manager, refreshing them through the manager as needed
+func (c *SDKConfig) NewComputeClientWithManager(manager *tokens.Manager) (*compute.Client, error) {
+	if manager == nil {
+		return nil, fmt.Errorf("token manager is required")
+	}
+	return c.newComputeClient(compute.WithAuthorizer(manager.Authorizer(ComputeResourceServer)))
+}
+
+// newComputeClient creates a Compute client authorized by authOption
+func (c *SDKConfig) newComputeClient(authOption compute.ClientOption) (*compute.Client, error) {
 	// Create options for the compute client
 	options := []compute.ClientOption{
-		compute.WithAccessToken(accessToken),
+		authOption,
 	}
 
 	// Add debugging if configured
@@ -258,14 +361,30 @@ func (c *SDKConfig) NewComputeClient(accessToken string) (*compute.Client, error
 		computeClient.Client.HTTPClient = serviceClient
 	}
 
+	c.applyClientOptions(computeClient.Client)
+
 	return computeClient, nil
 }
 
 // NewTimersClient creates a new Timers client with the SDK configuration
 func (c *SDKConfig) NewTimersClient(accessToken string) (*timers.Client, error) {
+	return c.newTimersClient(timers.WithAccessToken(accessToken))
+}
+
+// NewTimersClientWithManager creates a new Timers client that takes its tokens
+// from manager, refreshing them through the manager as needed
+func (c *SDKConfig) NewTimersClientWithManager(manager *tokens.Manager) (*timers.Client, error) {
+	if manager == nil {
+		return nil, fmt.Errorf("token manager is required")
+	}
+	return c.newTimersClient(timers.WithAuthorizer(manager.Authorizer(TimersResourceServer)))
+}
+
+// newTimersClient creates a Timers client authorized by authOption
+func (c *SDKConfig) newTimersClient(authOption timers.ClientOption) (*timers.Client, error) {
 	// Create options for the timers client
 	options := []timers.ClientOption{
-		timers.WithAccessToken(accessToken),
+		authOption,
 	}
 
 	// Add debugging if configured
@@ -294,6 +413,8 @@ func (c *SDKConfig) NewTimersClient(accessToken string) (*timers.Client, error)
 		timersClient.Client.HTTPClient = serviceClient
 	}
 
+	c.applyClientOptions(timersClient.Client)
+
 	return timersClient, nil
 }
 
@@ -424,19 +545,26 @@ func (c *SDKConfig) WithConfig(config *config.Config) *SDKConfig {
 	return c
 }
 
-// WithClientOption adds a client option to the configuration
+// WithClientOption adds a client option that is applied to the base client of
+// every service client created from the configuration, after the SDK's own
+// settings, for example to add middleware with core.WithMiddleware
 func (c *SDKConfig) WithClientOption(option core.ClientOption) *SDKConfig {
 	if c.Config == nil {
 		c.Config = config.DefaultConfig()
 	}
 
-	// Create a temporary client to apply the option
-	tempClient := &core.Client{}
-	option(tempClient)
+	c.clientOptions = append(c.clientOptions, option)
 
 	return c
 }
 
+// applyClientOptions applies the options added with WithClientOption
+func (c *SDKConfig) applyClientOptions(client *core.Client) {
+	for _, option := range c.clientOptions {
+		option(client)
+	}
+}
+
 // GetScopesByService returns the OAuth2 scopes needed for the specified services
 func GetScopesByService(services ...string) []string {
 	scopes := make([]string, 0, len(services))
@@ -455,6 +583,8 @@ func GetScopesByService(services ...string) []string {
 			scopes = append(scopes, FlowsScope)
 		case "compute":
 			scopes = append(scopes, ComputeScope)
+		case "timers":
+			scopes = append(scopes, TimersScope)
 		}
 	}
 
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Scott Friedman and Project Contributors
+package pkg_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/scopes"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/services/tokens"
+)
+
+// TestClientsWithManager verifies that service clients created from a token
+// manager authorize requests with the manager's current tokens
+func TestClientsWithManager(t *testing.T) {
+	config := pkg.NewConfig()
+	manager, err := config.NewTokenManager(tokens.WithStorage(tokens.NewMemoryStorage()))
+	if err != nil {
+		t.Fatalf("NewTokenManager() error = %v", err)
+	}
+	ctx := context.Background()
+
+	for _, resource := range []string{pkg.TransferResourceServer, pkg.SearchResourceServer} {
+		manager.StoreToken(ctx, &tokens.Entry{
+			Resource:    resource,
+			AccessToken: resource + "-token",
+			ExpiresAt:   time.Now().Add(time.Hour),
+		})
+	}
+
+	transferClient, err := config.NewTransferClientWithManager(manager)
+	if err != nil {
+		t.Fatalf("NewTransferClientWithManager() error = %v", err)
+	}
+	searchClient, err := config.NewSearchClientWithManager(manager)
+	if err != nil {
+		t.Fatalf("NewSearchClientWithManager() error = %v", err)
+	}
+
+	if header, err := transferClient.Client.Authorizer.GetAuthorizationHeader(ctx); err != nil || header != "Bearer transfer.api.globus.org-token" {
+		t.Errorf("Transfer authorization = %q, %v", header, err)
+	}
+	if header, err := searchClient.Client.Authorizer.GetAuthorizationHeader(ctx); err != nil || header != "Bearer search.api.globus.org-token" {
+		t.Errorf("Search authorization = %q, %v", header, err)
+	}
+
+	// A token stored later is picked up without recreating the client
+	manager.StoreToken(ctx, &tokens.Entry{
+		Resource:    pkg.TransferResourceServer,
+		AccessToken: "replacement-token",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	if header, _ := transferClient.Client.Authorizer.GetAuthorizationHeader(ctx); header != "Bearer replacement-token" {
+		t.Errorf("Transfer authorization after store = %q", header)
+	}
+
+	if _, err := config.NewGroupsClientWithManager(nil); err == nil {
+		t.Error("NewGroupsClientWithManager(nil) returned no error")
+	}
+}
+
+// TestWithClientOption verifies that client options from the configuration
+// reach every service client
+func TestWithClientOption(t *testing.T) {
+	config := pkg.NewConfig().
+		WithClientOption(core.WithMiddleware(core.HeaderMiddleware(nil))).
+		WithClientOption(core.WithBaseURL("https://example.org/"))
+
+	transferClient, err := config.NewTransferClient("token")
+	if err != nil {
+		t.Fatalf("NewTransferClient() error = %v", err)
+	}
+	groupsClient, err := config.NewGroupsClient("token")
+	if err != nil {
+		t.Fatalf("NewGroupsClient() error = %v", err)
+	}
+
+	for name, client := range map[string]*core.Client{"transfer": transferClient.Client, "groups": groupsClient.Client} {
+		if len(client.Middleware) != 1 || client.BaseURL != "https://example.org/" {
+			t.Errorf("%s client: middleware %d, base URL %q", name, len(client.Middleware), client.BaseURL)
+		}
+	}
+}
+
+// TestTimersScopeMatchesResourceServer verifies that the Timers scope is
+// issued by the Timers resource server
+func TestTimersScopeMatchesResourceServer(t *testing.T) {
+	want := "https://auth.globus.org/scopes/" + pkg.TimersResourceServer + "/timer"
+	if pkg.TimersScope != want {
+		t.Errorf("TimersScope = %q, want %q", pkg.TimersScope, want)
+	}
+	if scopes.TimersAll != want {
+		t.Errorf("scopes.TimersAll = %q, want %q", scopes.TimersAll, want)
+	}
+}
@@ -0,0 +1,556 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+	httppool "github.com/scttfrdmn/globus-go-sdk/pkg/core/http"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/ratelimit"
+)
+
+// PrometheusContentType is the content type of the Prometheus text
+// exposition format written by Collector
+const PrometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the request
+// latency histogram buckets
+var DefaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// Collector exposes transfer, connection pool, rate limiter and request
+// latency metrics in the Prometheus text exposition format. It implements
+// http.Handler, so it can be mounted on a metrics endpoint directly.
+type Collector struct {
+	monitor     PerformanceMonitor
+	poolManager *httppool.ConnectionPoolManager
+	limiters    map[string]ratelimit.RateLimiter
+	buckets     []float64
+
+	// latency holds request histograms keyed by host, method and endpoint
+	latency map[requestKey]*latencyHistogram
+
+	// pairs holds transfer totals keyed by endpoint pair, and counted the
+	// progress of each transfer already added to them
+	pairs   map[endpointPair]*pairTotals
+	counted map[string]transferProgress
+	mu      sync.Mutex
+}
+
+// CollectorOption configures a Collector
+type CollectorOption func(*Collector)
+
+// WithMonitor reports the transfers tracked by a performance monitor
+func WithMonitor(monitor PerformanceMonitor) CollectorOption {
+	return func(c *Collector) {
+		c.monitor = monitor
+	}
+}
+
+// WithPoolManager reports the connection pools of a pool manager, such as
+// httppool.GlobalHttpPoolManager used by the service clients
+func WithPoolManager(manager *httppool.ConnectionPoolManager) CollectorOption {
+	return func(c *Collector) {
+		c.poolManager = manager
+	}
+}
+
+// WithRateLimiter reports the statistics of a rate limiter under name
+func WithRateLimiter(name string, limiter ratelimit.RateLimiter) CollectorOption {
+	return func(c *Collector) {
+		c.limiters[name] = limiter
+	}
+}
+
+// WithLatencyBuckets sets the upper bounds, in seconds, of the request
+// latency histogram buckets
+func WithLatencyBuckets(buckets ...float64) CollectorOption {
+	return func(c *Collector) {
+		c.buckets = append([]float64(nil), buckets...)
+		sort.Float64s(c.buckets)
+	}
+}
+
+// NewCollector creates a collector for the given sources. Request latency is
+// recorded by the middleware returned by Middleware.
+func NewCollector(options ...CollectorOption) *Collector {
+	c := &Collector{
+		limiters: make(map[string]ratelimit.RateLimiter),
+		buckets:  DefaultLatencyBuckets,
+		latency:  make(map[requestKey]*latencyHistogram),
+		pairs:    make(map[endpointPair]*pairTotals),
+		counted:  make(map[string]transferProgress),
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// requestKey identifies a request latency histogram
+type requestKey struct {
+	host     string
+	method   string
+	endpoint string
+}
+
+// endpointPair identifies the transfer totals of a source and destination
+type endpointPair struct {
+	source      string
+	destination string
+}
+
+// transferProgress is the progress of a transfer that only grows
+type transferProgress struct {
+	bytes, files, errors, retries float64
+}
+
+// pairTotals are the totals of the transfers between an endpoint pair
+type pairTotals struct {
+	transferProgress
+	active int
+}
+
+// latencyHistogram counts request durations and results for one endpoint
+type latencyHistogram struct {
+	counts []uint64 // per bucket, not cumulative
+	sum    float64
+	count  uint64
+	codes  map[string]uint64
+}
+
+// Middleware returns core middleware that records the latency of every
+// request in the collector, for use with core.WithMiddleware
+func (c *Collector) Middleware() core.Middleware {
+	return core.MetricsMiddleware(c.ObserveRequest)
+}
+
+// ObserveRequest records a completed request. Path segments that look like
+// IDs are replaced with {id} so that requests to the same API endpoint share
+// a histogram.
+func (c *Collector) ObserveRequest(request core.RequestMetrics) {
+	key := requestKey{host: request.Host, method: request.Method, endpoint: endpointPath(request.Path)}
+	code := "error"
+	if request.StatusCode > 0 {
+		code = strconv.Itoa(request.StatusCode)
+	}
+	seconds := request.Duration.Seconds()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	h, ok := c.latency[key]
+	if !ok {
+		h = &latencyHistogram{counts: make([]uint64, len(c.buckets)), codes: make(map[string]uint64)}
+		c.latency[key] = h
+	}
+	for i, bound := range c.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+			break
+		}
+	}
+	h.sum += seconds
+	h.count++
+	h.codes[code]++
+}
+
+// ServeHTTP writes the current metrics in the Prometheus text format
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", PrometheusContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	_, _ = w.Write(buf.Bytes())
+}
+
+// WriteTo writes the current metrics to w in the Prometheus text format
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	var e exposition
+	c.writeTransfers(&e)
+	c.writePools(&e)
+	c.writeLimiters(&e)
+	c.writeRequests(&e)
+	n, err := w.Write(e.buf.Bytes())
+	return int64(n), err
+}
+
+// transferLabels are the labels of the metrics of an active transfer
+var transferLabels = []string{"transfer_id", "task_id", "source_endpoint", "destination_endpoint"}
+
+// pairLabels are the labels of the transfer totals
+var pairLabels = []string{"source_endpoint", "destination_endpoint"}
+
+// writeTransfers writes the progress of active transfers and the totals of
+// every endpoint pair. Only active transfers get their own series, so the
+// number of series does not grow with every transfer ever monitored.
+func (c *Collector) writeTransfers(e *exposition) {
+	if c.monitor == nil {
+		return
+	}
+
+	// Totals include finished transfers when the monitor can list them
+	var ids []string
+	if lister, ok := c.monitor.(interface{ ListTransfers() []string }); ok {
+		ids = lister.ListTransfers()
+	} else {
+		ids = c.monitor.ListActiveTransfers()
+	}
+	sort.Strings(ids)
+
+	type transferSample struct {
+		labels []string
+		values []float64
+	}
+	var samples []transferSample
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, totals := range c.pairs {
+		totals.active = 0
+	}
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		m, ok := c.monitor.GetMetrics(id)
+		if !ok {
+			continue
+		}
+		seen[id] = true
+		m.mu.RLock()
+		pair := endpointPair{source: m.SourceEndpoint, destination: m.DestEndpoint}
+		progress := transferProgress{
+			bytes:   float64(m.BytesTransferred),
+			files:   float64(m.FilesTransferred),
+			errors:  float64(m.ErrorCount),
+			retries: float64(m.RetryCount),
+		}
+		active := m.Status == "ACTIVE"
+		if active {
+			samples = append(samples, transferSample{
+				labels: []string{m.TransferID, m.TaskID, m.SourceEndpoint, m.DestEndpoint},
+				values: []float64{
+					float64(m.BytesTransferred),
+					float64(m.TotalBytes),
+					float64(m.FilesTransferred),
+					float64(m.FilesTotal),
+					m.BytesPerSecond,
+					m.AvgBytesPerSecond,
+				},
+			})
+		}
+		m.mu.RUnlock()
+
+		totals, ok := c.pairs[pair]
+		if !ok {
+			totals = &pairTotals{}
+			c.pairs[pair] = totals
+		}
+		if active {
+			totals.active++
+		}
+		totals.add(c.counted[id], progress)
+		c.counted[id] = progress
+	}
+	// Forget transfers the monitor no longer holds; their progress stays in
+	// the totals
+	for id := range c.counted {
+		if !seen[id] {
+			delete(c.counted, id)
+		}
+	}
+
+	families := []struct {
+		name, help string
+	}{
+		{"globus_transfer_bytes_transferred", "Bytes transferred so far"},
+		{"globus_transfer_bytes_expected", "Bytes the transfer is expected to move, or 0 if unknown"},
+		{"globus_transfer_files_transferred", "Files transferred so far"},
+		{"globus_transfer_files_expected", "Files the transfer is expected to move, or 0 if unknown"},
+		{"globus_transfer_throughput_bytes_per_second", "Throughput since the previous progress update"},
+		{"globus_transfer_average_throughput_bytes_per_second", "Average throughput since the transfer started"},
+	}
+	if len(samples) > 0 {
+		for i, family := range families {
+			e.header(family.name, "gauge", family.help)
+			for _, sample := range samples {
+				e.sample(family.name, transferLabels, sample.labels, sample.values[i])
+			}
+		}
+	}
+
+	if len(c.pairs) == 0 {
+		return
+	}
+	pairs := make([]endpointPair, 0, len(c.pairs))
+	for pair := range c.pairs {
+		pairs = append(pairs, pair)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].source != pairs[j].source {
+			return pairs[i].source < pairs[j].source
+		}
+		return pairs[i].destination < pairs[j].destination
+	})
+
+	totalFamilies := []struct {
+		name, kind, help string
+		value            func(*pairTotals) float64
+	}{
+		{"globus_transfers_active", "gauge", "Active transfers between the endpoints",
+			func(t *pairTotals) float64 { return float64(t.active) }},
+		{"globus_transfer_bytes_total", "counter", "Bytes transferred between the endpoints",
+			func(t *pairTotals) float64 { return t.bytes }},
+		{"globus_transfer_files_total", "counter", "Files transferred between the endpoints",
+			func(t *pairTotals) float64 { return t.files }},
+		{"globus_transfer_errors_total", "counter", "Errors recorded for transfers between the endpoints",
+			func(t *pairTotals) float64 { return t.errors }},
+		{"globus_transfer_retries_total", "counter", "Retries recorded for transfers between the endpoints",
+			func(t *pairTotals) float64 { return t.retries }},
+	}
+	for _, family := range totalFamilies {
+		e.header(family.name, family.kind, family.help)
+		for _, pair := range pairs {
+			e.sample(family.name, pairLabels, []string{pair.source, pair.destination}, family.value(c.pairs[pair]))
+		}
+	}
+}
+
+// add adds the progress a transfer made since it was last counted. A
+// transfer whose progress went backwards was restarted and is counted anew.
+func (t *pairTotals) add(last, current transferProgress) {
+	t.bytes += growth(last.bytes, current.bytes)
+	t.files += growth(last.files, current.files)
+	t.errors += growth(last.errors, current.errors)
+	t.retries += growth(last.retries, current.retries)
+}
+
+// growth returns how much a monotonic value grew from last to current
+func growth(last, current float64) float64 {
+	if current < last {
+		return current
+	}
+	return current - last
+}
+
+// writePools writes the statistics of every connection pool
+func (c *Collector) writePools(e *exposition) {
+	if c.poolManager == nil {
+		return
+	}
+	stats := c.poolManager.GetAllStats()
+	if len(stats) == 0 {
+		return
+	}
+	services := make([]string, 0, len(stats))
+	for service := range stats {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	families := []struct {
+		name, help string
+		value      func(httppool.ConnectionPoolStats) float64
+	}{
+		{"globus_connection_pool_active_connections", "Active connections in the pool",
+			func(s httppool.ConnectionPoolStats) float64 { return float64(s.TotalActive) }},
+		{"globus_connection_pool_active_hosts", "Hosts with active connections in the pool",
+			func(s httppool.ConnectionPoolStats) float64 { return float64(s.ActiveHosts) }},
+		{"globus_connection_pool_max_connections_per_host", "Connection limit per host, or 0 if unlimited",
+			func(s httppool.ConnectionPoolStats) float64 { return float64(s.Config.MaxConnsPerHost) }},
+		{"globus_connection_pool_max_idle_connections", "Idle connections kept across all hosts",
+			func(s httppool.ConnectionPoolStats) float64 { return float64(s.Config.MaxIdleConns) }},
+	}
+	for _, family := range families {
+		e.header(family.name, "gauge", family.help)
+		for _, service := range services {
+			e.sample(family.name, []string{"service"}, []string{service}, family.value(stats[service]))
+		}
+	}
+}
+
+// writeLimiters writes the statistics of every rate limiter
+func (c *Collector) writeLimiters(e *exposition) {
+	if len(c.limiters) == 0 {
+		return
+	}
+	names := make([]string, 0, len(c.limiters))
+	stats := make(map[string]ratelimit.RateLimiterStats, len(c.limiters))
+	for name, limiter := range c.limiters {
+		names = append(names, name)
+		stats[name] = limiter.GetStats()
+	}
+	sort.Strings(names)
+
+	families := []struct {
+		name, kind, help string
+		value            func(ratelimit.RateLimiterStats) float64
+	}{
+		{"globus_rate_limiter_requests_total", "counter", "Requests admitted by the rate limiter",
+			func(s ratelimit.RateLimiterStats) float64 { return float64(s.TotalRequests) }},
+		{"globus_rate_limiter_throttled_total", "counter", "Requests that had to wait for the rate limiter",
+			func(s ratelimit.RateLimiterStats) float64 { return float64(s.TotalThrottled) }},
+		{"globus_rate_limiter_wait_seconds_total", "counter", "Time spent waiting for the rate limiter",
+			func(s ratelimit.RateLimiterStats) float64 { return s.TotalWaitTime.Seconds() }},
+		{"globus_rate_limiter_limit", "gauge", "Current rate limit in requests per second",
+			func(s ratelimit.RateLimiterStats) float64 { return s.CurrentLimit }},
+		{"globus_rate_limiter_remaining_tokens", "gauge", "Tokens currently available",
+			func(s ratelimit.RateLimiterStats) float64 { return s.RemainingTokens }},
+	}
+	for _, family := range families {
+		e.header(family.name, family.kind, family.help)
+		for _, name := range names {
+			e.sample(family.name, []string{"limiter"}, []string{name}, family.value(stats[name]))
+		}
+	}
+}
+
+// requestLabels are the labels of the request metrics
+var requestLabels = []string{"host", "method", "endpoint"}
+
+// writeRequests writes the request counters and latency histograms
+func (c *Collector) writeRequests(e *exposition) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.latency) == 0 {
+		return
+	}
+	keys := make([]requestKey, 0, len(c.latency))
+	for key := range c.latency {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].host != keys[j].host {
+			return keys[i].host < keys[j].host
+		}
+		if keys[i].endpoint != keys[j].endpoint {
+			return keys[i].endpoint < keys[j].endpoint
+		}
+		return keys[i].method < keys[j].method
+	})
+
+	e.header("globus_requests_total", "counter", "Requests by endpoint and status code, or error if no response was received")
+	for _, key := range keys {
+		h := c.latency[key]
+		codes := make([]string, 0, len(h.codes))
+		for code := range h.codes {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+		for _, code := range codes {
+			e.sample("globus_requests_total", append(requestLabels, "code"),
+				[]string{key.host, key.method, key.endpoint, code}, float64(h.codes[code]))
+		}
+	}
+
+	e.header("globus_request_duration_seconds", "histogram", "Request latency by endpoint")
+	for _, key := range keys {
+		h := c.latency[key]
+		values := []string{key.host, key.method, key.endpoint}
+		var cumulative uint64
+		for i, bound := range c.buckets {
+			cumulative += h.counts[i]
+			e.sample("globus_request_duration_seconds_bucket", append(requestLabels, "le"),
+				append(values, formatValue(bound)), float64(cumulative))
+		}
+		e.sample("globus_request_duration_seconds_bucket", append(requestLabels, "le"),
+			append(values, "+Inf"), float64(h.count))
+		e.sample("globus_request_duration_seconds_sum", requestLabels, values, h.sum)
+		e.sample("globus_request_duration_seconds_count", requestLabels, values, float64(h.count))
+	}
+}
+
+// endpointPath replaces the segments of a request path that look like IDs
+// with {id}
+func endpointPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if isIDSegment(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isIDSegment reports whether a path segment is a UUID or a number
+func isIDSegment(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	if _, err := strconv.ParseUint(segment, 10, 64); err == nil {
+		return true
+	}
+	if len(segment) != 36 {
+		return false
+	}
+	for i, r := range segment {
+		switch i {
+		case 8, 13, 18, 23:
+			if r != '-' {
+				return false
+			}
+		default:
+			if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// exposition builds a document in the Prometheus text format
+type exposition struct {
+	buf bytes.Buffer
+}
+
+// header writes the HELP and TYPE lines of a metric family
+func (e *exposition) header(name, kind, help string) {
+	help = strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help)
+	fmt.Fprintf(&e.buf, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// labelEscaper escapes label values
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// sample writes one sample line
+func (e *exposition) sample(name string, labels, values []string, value float64) {
+	e.buf.WriteString(name)
+	if len(labels) > 0 {
+		e.buf.WriteByte('{')
+		for i, label := range labels {
+			if i > 0 {
+				e.buf.WriteByte(',')
+			}
+			fmt.Fprintf(&e.buf, `%s="%s"`, label, labelEscaper.Replace(values[i]))
+		}
+		e.buf.WriteByte('}')
+	}
+	e.buf.WriteByte(' ')
+	e.buf.WriteString(formatValue(value))
+	e.buf.WriteByte('\n')
+}
+
+// formatValue formats a sample value or bucket bound
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
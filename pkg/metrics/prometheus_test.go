@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+	httppool "github.com/scttfrdmn/globus-go-sdk/pkg/core/http"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/ratelimit"
+)
+
+func TestCollector(t *testing.T) {
+	monitor := NewPerformanceMonitor()
+	monitor.StartMonitoring("transfer-1", "task-1", "source-ep", "dest \"ep\"", "Test Transfer")
+	monitor.SetTotalBytes("transfer-1", 1000)
+	monitor.SetTotalFiles("transfer-1", 4)
+	monitor.UpdateMetrics("transfer-1", 500, 2)
+	monitor.RecordRetry("transfer-1")
+
+	poolManager := httppool.NewConnectionPoolManager(nil)
+	poolManager.GetPool("transfer", nil)
+
+	limiter := ratelimit.NewTokenBucketLimiter(nil)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+
+	collector := NewCollector(
+		WithMonitor(monitor),
+		WithPoolManager(poolManager),
+		WithRateLimiter("transfer", limiter),
+		WithLatencyBuckets(1, 0.1),
+	)
+	taskPath := "/v0.10/task/0b8f3e2c-6f1a-11ee-9d42-0242ac120002"
+	collector.ObserveRequest(core.RequestMetrics{Method: "GET", Host: "transfer.api.globus.org", Path: taskPath, StatusCode: 200, Duration: 50 * time.Millisecond})
+	collector.ObserveRequest(core.RequestMetrics{Method: "GET", Host: "transfer.api.globus.org", Path: taskPath, StatusCode: 404, Duration: 500 * time.Millisecond})
+	collector.ObserveRequest(core.RequestMetrics{Method: "GET", Host: "transfer.api.globus.org", Path: taskPath, Duration: 2 * time.Second})
+
+	server := httptest.NewServer(collector)
+	defer server.Close()
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("GET error = %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("Content-Type") != PrometheusContentType {
+		t.Errorf("Content-Type = %q", resp.Header.Get("Content-Type"))
+	}
+	body, _ := io.ReadAll(resp.Body)
+	text := string(body)
+
+	transferLabels := `transfer_id="transfer-1",task_id="task-1",source_endpoint="source-ep",destination_endpoint="dest \"ep\""`
+	pairLabels := `source_endpoint="source-ep",destination_endpoint="dest \"ep\""`
+	request := `host="transfer.api.globus.org",method="GET",endpoint="/v0.10/task/{id}"`
+	for _, want := range []string{
+		"# TYPE globus_transfer_bytes_transferred gauge\n",
+		"globus_transfer_bytes_transferred{" + transferLabels + "} 500\n",
+		"globus_transfer_bytes_expected{" + transferLabels + "} 1000\n",
+		"globus_transfer_files_transferred{" + transferLabels + "} 2\n",
+		"globus_transfers_active{" + pairLabels + "} 1\n",
+		"# TYPE globus_transfer_bytes_total counter\n",
+		"globus_transfer_bytes_total{" + pairLabels + "} 500\n",
+		"globus_transfer_files_total{" + pairLabels + "} 2\n",
+		"globus_transfer_retries_total{" + pairLabels + "} 1\n",
+		`globus_connection_pool_max_idle_connections{service="transfer"} 100` + "\n",
+		`globus_rate_limiter_requests_total{limiter="transfer"} 1` + "\n",
+		"# TYPE globus_rate_limiter_wait_seconds_total counter\n",
+		"globus_requests_total{" + request + `,code="404"} 1` + "\n",
+		"globus_requests_total{" + request + `,code="error"} 1` + "\n",
+		"# TYPE globus_request_duration_seconds histogram\n",
+		"globus_request_duration_seconds_bucket{" + request + `,le="0.1"} 1` + "\n",
+		"globus_request_duration_seconds_bucket{" + request + `,le="1"} 2` + "\n",
+		"globus_request_duration_seconds_bucket{" + request + `,le="+Inf"} 3` + "\n",
+		"globus_request_duration_seconds_sum{" + request + "} 2.55\n",
+		"globus_request_duration_seconds_count{" + request + "} 3\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Exposition is missing %q\n%s", want, text)
+		}
+	}
+}
+
+func TestCollectorTransferTotals(t *testing.T) {
+	monitor := NewPerformanceMonitor()
+	collector := NewCollector(WithMonitor(monitor))
+	scrape := func() string {
+		var out strings.Builder
+		if _, err := collector.WriteTo(&out); err != nil {
+			t.Fatalf("WriteTo() error = %v", err)
+		}
+		return out.String()
+	}
+
+	pair := `{source_endpoint="src",destination_endpoint="dst"}`
+	monitor.StartMonitoring("transfer-1", "task-1", "src", "dst", "first")
+	monitor.UpdateMetrics("transfer-1", 100, 1)
+	scrape()
+	monitor.UpdateMetrics("transfer-1", 300, 3)
+	monitor.StopMonitoring("transfer-1")
+	monitor.StartMonitoring("transfer-2", "task-2", "src", "dst", "second")
+	monitor.UpdateMetrics("transfer-2", 50, 1)
+
+	// Finished transfers leave their own series but stay in the totals,
+	// which are not counted twice across scrapes
+	for i := 0; i < 2; i++ {
+		text := scrape()
+		if strings.Contains(text, `transfer_id="transfer-1"`) || !strings.Contains(text, `transfer_id="transfer-2"`) {
+			t.Errorf("Per-transfer series should cover only active transfers:\n%s", text)
+		}
+		for _, want := range []string{
+			"globus_transfers_active" + pair + " 1\n",
+			"globus_transfer_bytes_total" + pair + " 350\n",
+			"globus_transfer_files_total" + pair + " 4\n",
+		} {
+			if !strings.Contains(text, want) {
+				t.Errorf("Exposition is missing %q\n%s", want, text)
+			}
+		}
+	}
+}
+
+func TestCollectorMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	collector := NewCollector()
+	client := core.NewClient(core.WithMiddleware(collector.Middleware()))
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/groups/42/members", nil)
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	var out strings.Builder
+	if _, err := collector.WriteTo(&out); err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+	if !strings.Contains(out.String(), `endpoint="/groups/{id}/members",code="200"} 1`) {
+		t.Errorf("Exposition = %s", out.String())
+	}
+}
@@ -262,6 +262,19 @@ func (m *DefaultPerformanceMonitor) ListActiveTransfers() []string {
 	return activeTransfers
 }
 
+// ListTransfers lists all transfers being monitored, including finished ones
+func (m *DefaultPerformanceMonitor) ListTransfers() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	transfers := make([]string, 0, len(m.metrics))
+	for id := range m.metrics {
+		transfers = append(transfers, id)
+	}
+
+	return transfers
+}
+
 // SetTotalBytes sets the total bytes expected for a transfer
 func (m *DefaultPerformanceMonitor) SetTotalBytes(transferID string, totalBytes int64) {
 	m.mu.RLock()
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+
+/*
+Package scopes builds and parses Globus Auth scope strings.
+
+Globus scopes can depend on other scopes, written in brackets after the scope
+they belong to. A "*" marks a dependency as optional, so consent to it can be
+granted later:
+
+	urn:globus:auth:scope:transfer.api.globus.org:all[*https://auth.globus.org/scopes/<collection>/data_access]
+
+Scope represents one scope and its dependencies as a tree:
+
+	scope := scopes.TransferWithDataAccess(sourceCollection, destinationCollection)
+	url := authClient.GetAuthorizationURL(state, scope.String())
+
+	// Flows that move data need the Transfer scope as a dependency
+	run := scopes.FlowUser(flowID).WithDependencies(scope)
+
+Parse reads scope strings, including those returned by Globus Auth in token
+responses and consent errors:
+
+	parsed, err := scopes.Parse("openid email urn:globus:auth:scope:transfer.api.globus.org:all[*https://auth.globus.org/scopes/<id>/data_access]")
+
+The package also defines the scope strings of every Globus service, such as
+TransferAll, GroupsAll and FlowsRunManage.
+*/
+package scopes
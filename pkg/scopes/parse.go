@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package scopes
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError describes malformed scope text
+type ParseError struct {
+	// Input is the text being parsed
+	Input string
+
+	// Offset is the byte offset at which the error was found
+	Offset int
+
+	// Message describes the problem
+	Message string
+}
+
+// Error returns a string representation of the error
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid scope string at offset %d: %s: %q", e.Offset, e.Message, e.Input)
+}
+
+// Parse parses a space-separated list of scopes in the bracket syntax
+func Parse(text string) ([]Scope, error) {
+	p := &parser{input: text}
+	scopes, err := p.parseList(false)
+	if err != nil {
+		return nil, err
+	}
+	return scopes, nil
+}
+
+// ParseScope parses text that holds exactly one scope
+func ParseScope(text string) (Scope, error) {
+	scopes, err := Parse(text)
+	if err != nil {
+		return Scope{}, err
+	}
+	if len(scopes) != 1 {
+		return Scope{}, &ParseError{Input: text, Message: fmt.Sprintf("expected one scope, found %d", len(scopes))}
+	}
+	return scopes[0], nil
+}
+
+// MustParseScope is like ParseScope but panics on malformed text. It is
+// meant for scope strings known at compile time.
+func MustParseScope(text string) Scope {
+	scope, err := ParseScope(text)
+	if err != nil {
+		panic(err)
+	}
+	return scope
+}
+
+// parser is a recursive descent parser over the scope grammar:
+//
+//	list  = { scope }
+//	scope = [ "*" ] name [ "[" list "]" ]
+type parser struct {
+	input string
+	pos   int
+}
+
+// parseList parses scopes until the end of input, or until a closing
+// bracket when nested
+func (p *parser) parseList(nested bool) ([]Scope, error) {
+	var scopes []Scope
+	for {
+		p.skipSpace()
+		if p.pos == len(p.input) {
+			if nested {
+				return nil, p.errorf("missing closing bracket")
+			}
+			return scopes, nil
+		}
+		if p.input[p.pos] == ']' {
+			if !nested {
+				return nil, p.errorf("unexpected closing bracket")
+			}
+			if len(scopes) == 0 {
+				return nil, p.errorf("empty dependency list")
+			}
+			p.pos++
+			return scopes, nil
+		}
+
+		scope, err := p.parseScope()
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+}
+
+// parseScope parses one scope with its optional marker and dependencies
+func (p *parser) parseScope() (Scope, error) {
+	var scope Scope
+	if p.input[p.pos] == '*' {
+		scope.Optional = true
+		p.pos++
+	}
+
+	start := p.pos
+	for p.pos < len(p.input) && !isDelimiter(p.input[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start {
+		if scope.Optional {
+			return Scope{}, p.errorf("optional marker must be followed by a scope")
+		}
+		return Scope{}, p.errorf("expected a scope")
+	}
+	scope.Name = p.input[start:p.pos]
+
+	// Dependencies may be separated from their scope by whitespace
+	next := p.pos
+	for next < len(p.input) && isSpace(p.input[next]) {
+		next++
+	}
+	if next < len(p.input) && p.input[next] == '[' {
+		p.pos = next + 1
+		dependencies, err := p.parseList(true)
+		if err != nil {
+			return Scope{}, err
+		}
+		scope.Dependencies = dependencies
+	}
+	return scope, nil
+}
+
+// skipSpace advances past whitespace
+func (p *parser) skipSpace() {
+	for p.pos < len(p.input) && isSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// errorf returns a ParseError at the current position
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &ParseError{Input: p.input, Offset: p.pos, Message: fmt.Sprintf(format, args...)}
+}
+
+// isDelimiter reports whether c ends a scope name
+func isDelimiter(c byte) bool {
+	return c == '[' || c == ']' || c == '*' || isSpace(c)
+}
+
+// isSpace reports whether c separates scopes
+func isSpace(c byte) bool {
+	return strings.IndexByte(" \t\r\n", c) >= 0
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package scopes
+
+import (
+	"strings"
+)
+
+// Scope is a Globus Auth scope with the scopes it depends on
+type Scope struct {
+	// Name is the scope string, such as a URN or URL
+	Name string
+
+	// Optional marks the scope with "*" so that consent to it can be
+	// granted separately. It is only meaningful for dependencies.
+	Optional bool
+
+	// Dependencies are the scopes this scope depends on
+	Dependencies []Scope
+}
+
+// New creates a scope with the given dependencies
+func New(name string, dependencies ...Scope) Scope {
+	return Scope{Name: name, Dependencies: dependencies}
+}
+
+// WithDependencies returns a copy of the scope with dependencies added
+func (s Scope) WithDependencies(dependencies ...Scope) Scope {
+	s.Dependencies = append(append([]Scope(nil), s.Dependencies...), dependencies...)
+	return s
+}
+
+// AsOptional returns a copy of the scope marked optional
+func (s Scope) AsOptional() Scope {
+	s.Optional = true
+	return s
+}
+
+// String serializes the scope in the bracket syntax understood by Globus Auth
+func (s Scope) String() string {
+	var b strings.Builder
+	s.write(&b)
+	return b.String()
+}
+
+// write appends the serialized scope to b
+func (s Scope) write(b *strings.Builder) {
+	if s.Optional {
+		b.WriteByte('*')
+	}
+	b.WriteString(s.Name)
+	if len(s.Dependencies) == 0 {
+		return
+	}
+
+	b.WriteByte('[')
+	for i, dependency := range s.Dependencies {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		dependency.write(b)
+	}
+	b.WriteByte(']')
+}
+
+// Contains reports whether the scope or any of its dependencies, at any
+// depth, has the given name
+func (s Scope) Contains(name string) bool {
+	if s.Name == name {
+		return true
+	}
+	for _, dependency := range s.Dependencies {
+		if dependency.Contains(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Strings serializes each scope, for APIs that take scope strings such as
+// auth.Client.GetAuthorizationURL
+func Strings(scopes ...Scope) []string {
+	serialized := make([]string, len(scopes))
+	for i, scope := range scopes {
+		serialized[i] = scope.String()
+	}
+	return serialized
+}
+
+// Join serializes scopes as one space-separated string, the form used in
+// OAuth2 requests
+func Join(scopes ...Scope) string {
+	return strings.Join(Strings(scopes...), " ")
+}
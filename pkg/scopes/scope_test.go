@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package scopes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestScopeString(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope Scope
+		want  string
+	}{
+		{
+			name:  "plain",
+			scope: New(TransferAll),
+			want:  TransferAll,
+		},
+		{
+			name:  "optional dependencies",
+			scope: TransferWithDataAccess("c1", "", "c2"),
+			want:  TransferAll + "[*https://auth.globus.org/scopes/c1/data_access *https://auth.globus.org/scopes/c2/data_access]",
+		},
+		{
+			name:  "nested",
+			scope: FlowUser("a-b").WithDependencies(TransferWithDataAccess("c1")),
+			want:  "https://auth.globus.org/scopes/a-b/flow_a_b_user[" + TransferAll + "[*https://auth.globus.org/scopes/c1/data_access]]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scope.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithDependenciesCopies(t *testing.T) {
+	base := New(TransferAll, CollectionDataAccess("c1"))
+	extended := base.WithDependencies(CollectionDataAccess("c2"))
+	extended.Dependencies[0].Optional = true
+
+	if len(base.Dependencies) != 1 || base.Dependencies[0].Optional {
+		t.Errorf("WithDependencies() modified the original scope: %v", base)
+	}
+	if !extended.Contains(CollectionDataAccess("c2").Name) || base.Contains(CollectionDataAccess("c2").Name) {
+		t.Error("Contains() does not reflect added dependencies")
+	}
+}
+
+func TestParse(t *testing.T) {
+	text := "openid  email\t" + TransferAll + " [ *https://auth.globus.org/scopes/c1/data_access\n" +
+		"https://auth.globus.org/scopes/c2/data_access[*x[y]]]"
+
+	got, err := Parse(text)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	want := []Scope{
+		New(OpenID),
+		New(Email),
+		New(TransferAll,
+			CollectionDataAccess("c1").AsOptional(),
+			CollectionDataAccess("c2").WithDependencies(New("x", New("y")).AsOptional()),
+		),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() = %#v, want %#v", got, want)
+	}
+
+	// Serializing and parsing again gives the same tree
+	again, err := Parse(Join(got...))
+	if err != nil || !reflect.DeepEqual(again, want) {
+		t.Errorf("Parse(Join()) = %v, %v", again, err)
+	}
+
+	if scopes, err := Parse("   "); err != nil || len(scopes) != 0 {
+		t.Errorf("Parse(blank) = %v, %v", scopes, err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, text := range []string{
+		"a[b",
+		"a]",
+		"a[]",
+		"*",
+		"a[*]",
+		"[b]",
+		"a[b]]",
+	} {
+		_, err := Parse(text)
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("Parse(%q) error = %v, want *ParseError", text, err)
+		}
+	}
+
+	if _, err := ParseScope("a b"); err == nil {
+		t.Error("ParseScope() accepted two scopes")
+	}
+	if scope := MustParseScope("a[*b]"); scope.String() != "a[*b]" {
+		t.Errorf("MustParseScope() = %v", scope)
+	}
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package scopes
+
+import (
+	"strings"
+)
+
+// OpenID Connect scopes issued by Globus Auth
+const (
+	// OpenID is the scope for the user's identity ID
+	OpenID = "openid"
+
+	// Email is the scope for the user's email address
+	Email = "email"
+
+	// Profile is the scope for the user's name and organization
+	Profile = "profile"
+
+	// OfflineAccess requests refresh tokens
+	OfflineAccess = "offline_access"
+)
+
+// Auth service scopes
+const (
+	// AuthViewIdentities allows looking up identities
+	AuthViewIdentities = "urn:globus:auth:scope:auth.globus.org:view_identities"
+
+	// AuthViewIdentitySet allows listing the identities linked to the user
+	AuthViewIdentitySet = "urn:globus:auth:scope:auth.globus.org:view_identity_set"
+
+	// AuthViewConsents allows listing the user's consents
+	AuthViewConsents = "urn:globus:auth:scope:auth.globus.org:view_consents"
+
+	// AuthManageProjects allows managing developer projects and clients
+	AuthManageProjects = "urn:globus:auth:scope:auth.globus.org:manage_projects"
+)
+
+// Service scopes
+const (
+	// TransferAll is the Transfer service scope
+	TransferAll = "urn:globus:auth:scope:transfer.api.globus.org:all"
+
+	// GroupsAll is the Groups service scope
+	GroupsAll = "urn:globus:auth:scope:groups.api.globus.org:all"
+
+	// GroupsViewMyGroupsAndMemberships allows reading the user's groups only
+	GroupsViewMyGroupsAndMemberships = "urn:globus:auth:scope:groups.api.globus.org:view_my_groups_and_memberships"
+
+	// SearchAll is the Search service scope
+	SearchAll = "urn:globus:auth:scope:search.api.globus.org:all"
+
+	// SearchIngest allows ingesting documents into Search indices
+	SearchIngest = "urn:globus:auth:scope:search.api.globus.org:ingest"
+
+	// SearchQuery allows querying Search indices
+	SearchQuery = "urn:globus:auth:scope:search.api.globus.org:search"
+
+	// FlowsManageFlows allows creating, updating and deleting flows
+	FlowsManageFlows = flowsScopePrefix + "manage_flows"
+
+	// FlowsViewFlows allows reading flow definitions
+	FlowsViewFlows = flowsScopePrefix + "view_flows"
+
+	// FlowsRunStatus allows reading the status of runs
+	FlowsRunStatus = flowsScopePrefix + "run_status"
+
+	// FlowsRunManage allows cancelling and updating runs
+	FlowsRunManage = flowsScopePrefix + "run_manage"
+
+	// ComputeAll is the Compute service scope
+	ComputeAll = "https://auth.globus.org/scopes/facd7ccc-c5f4-42aa-916b-a0e270e2c2a9/all"
+
+	// TimersAll is the Timers service scope
+	TimersAll = "https://auth.globus.org/scopes/524230d7-ea86-4a52-8312-86065a9e0417/timer"
+)
+
+// flowsScopePrefix is the prefix of the scopes of the Flows service
+const flowsScopePrefix = "https://auth.globus.org/scopes/eec9b274-0c81-4334-bdc2-54e90e689b9a/"
+
+// authScopePrefix is the prefix of scopes named by a resource server ID
+const authScopePrefix = "https://auth.globus.org/scopes/"
+
+// CollectionDataAccess returns the data_access scope of a mapped collection.
+// It is usually an optional dependency of TransferAll; see
+// TransferWithDataAccess.
+func CollectionDataAccess(collectionID string) Scope {
+	return New(authScopePrefix + collectionID + "/data_access")
+}
+
+// TransferWithDataAccess returns the Transfer scope with optional data_access
+// dependencies for the given collections. Empty collection IDs are ignored.
+func TransferWithDataAccess(collectionIDs ...string) Scope {
+	scope := New(TransferAll)
+	for _, id := range collectionIDs {
+		if id != "" {
+			scope.Dependencies = append(scope.Dependencies, CollectionDataAccess(id).AsOptional())
+		}
+	}
+	return scope
+}
+
+// FlowUser returns the scope needed to run a specific flow. Add the scopes
+// of the services the flow uses as dependencies.
+func FlowUser(flowID string) Scope {
+	return New(authScopePrefix + flowID + "/flow_" + strings.ReplaceAll(flowID, "-", "_") + "_user")
+}
+
+// GCSManageCollections returns the scope needed to manage the collections of
+// a Globus Connect Server endpoint
+func GCSManageCollections(endpointID string) Scope {
+	return New("urn:globus:auth:scope:" + endpointID + ":manage_collections")
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package scopes_test
+
+import (
+	"testing"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/scopes"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/services/compute"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/services/flows"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/services/groups"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/services/search"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/services/timers"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/services/transfer"
+)
+
+// TestServiceScopesMatchClients keeps the constants in step with the scopes
+// the service clients request
+func TestServiceScopesMatchClients(t *testing.T) {
+	pairs := map[string][2]string{
+		"transfer": {scopes.TransferAll, transfer.TransferScope},
+		"groups":   {scopes.GroupsAll, groups.GroupsScope},
+		"search":   {scopes.SearchAll, search.SearchScope},
+		"flows":    {scopes.FlowsManageFlows, flows.FlowsScope},
+		"compute":  {scopes.ComputeAll, compute.ComputeScope},
+		"timers":   {scopes.TimersAll, timers.TimersScope},
+	}
+	for service, pair := range pairs {
+		if pair[0] != pair[1] {
+			t.Errorf("%s scope = %q, client uses %q", service, pair[0], pair[1])
+		}
+	}
+}
+
+func TestFlowUser(t *testing.T) {
+	scope := scopes.FlowUser("5ec2e5d8-9c32-4b1c-9a09-bbd4f4d39f27")
+	want := "https://auth.globus.org/scopes/5ec2e5d8-9c32-4b1c-9a09-bbd4f4d39f27/flow_5ec2e5d8_9c32_4b1c_9a09_bbd4f4d39f27_user"
+	if scope.String() != want {
+		t.Errorf("FlowUser() = %q, want %q", scope, want)
+	}
+}
@@ -44,5 +44,14 @@ func (a *AuthorizerAdapter) GetToken() string {
 	return a.authorizer.GetToken()
 }
 
+// HandleMissingAuthorization implements the core.auth.MissingAuthorizationHandler
+// interface when the wrapped authorizer can refresh its credentials
+func (a *AuthorizerAdapter) HandleMissingAuthorization(ctx context.Context) bool {
+	if handler, ok := a.authorizer.(coreauthlib.MissingAuthorizationHandler); ok {
+		return handler.HandleMissingAuthorization(ctx)
+	}
+	return false
+}
+
 // Ensure AuthorizerAdapter implements the core.auth.Authorizer interface
 var _ coreauthlib.Authorizer = (*AuthorizerAdapter)(nil)
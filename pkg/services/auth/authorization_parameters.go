@@ -0,0 +1,318 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+// AuthorizationParameters describe the login a Globus service requires
+// before it will grant access, as returned in the authorization_parameters
+// field of error responses. Pass them to GetAuthorizationURLWithParameters to
+// send the user back to Globus Auth for a fresh or stronger login.
+type AuthorizationParameters struct {
+	// SessionMessage is a message to show the user on the login page
+	SessionMessage string `json:"session_message,omitempty"`
+
+	// SessionRequiredIdentities are identity IDs the user must log in with
+	SessionRequiredIdentities []string `json:"session_required_identities,omitempty"`
+
+	// SessionRequiredSingleDomain are domains, one of which the user must
+	// log in with an identity from
+	SessionRequiredSingleDomain []string `json:"session_required_single_domain,omitempty"`
+
+	// SessionRequiredMFA requires a login with multi-factor authentication
+	SessionRequiredMFA bool `json:"session_required_mfa,omitempty"`
+
+	// SessionRequiredPolicies are authentication policy IDs the session
+	// must satisfy
+	SessionRequiredPolicies []string `json:"session_required_policies,omitempty"`
+
+	// Prompt is "login" when the user must log in again even if they have a
+	// session
+	Prompt string `json:"prompt,omitempty"`
+
+	// RequiredScopes are scopes the user must consent to
+	RequiredScopes []string `json:"required_scopes,omitempty"`
+}
+
+// UnmarshalJSON decodes the parameters. Some services send the list fields
+// as comma-separated strings, which are split.
+func (p *AuthorizationParameters) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		SessionMessage              string          `json:"session_message"`
+		SessionRequiredIdentities   json.RawMessage `json:"session_required_identities"`
+		SessionRequiredSingleDomain json.RawMessage `json:"session_required_single_domain"`
+		SessionRequiredMFA          *bool           `json:"session_required_mfa"`
+		SessionRequiredPolicies     json.RawMessage `json:"session_required_policies"`
+		Prompt                      string          `json:"prompt"`
+		RequiredScopes              json.RawMessage `json:"required_scopes"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*p = AuthorizationParameters{
+		SessionMessage:     raw.SessionMessage,
+		SessionRequiredMFA: raw.SessionRequiredMFA != nil && *raw.SessionRequiredMFA,
+		Prompt:             raw.Prompt,
+	}
+	lists := []struct {
+		raw    json.RawMessage
+		target *[]string
+		name   string
+	}{
+		{raw.SessionRequiredIdentities, &p.SessionRequiredIdentities, "session_required_identities"},
+		{raw.SessionRequiredSingleDomain, &p.SessionRequiredSingleDomain, "session_required_single_domain"},
+		{raw.SessionRequiredPolicies, &p.SessionRequiredPolicies, "session_required_policies"},
+		{raw.RequiredScopes, &p.RequiredScopes, "required_scopes"},
+	}
+	for _, list := range lists {
+		values, err := decodeStringList(list.raw)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", list.name, err)
+		}
+		*list.target = values
+	}
+	return nil
+}
+
+// decodeStringList decodes a JSON list of strings or a comma-separated string
+func decodeStringList(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var values []string
+	if err := json.Unmarshal(raw, &values); err == nil {
+		return values, nil
+	}
+	var joined string
+	if err := json.Unmarshal(raw, &joined); err != nil {
+		return nil, errors.New("expected a list or a string")
+	}
+	for _, value := range strings.Split(joined, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values, nil
+}
+
+// IsZero reports whether no parameters are set
+func (p AuthorizationParameters) IsZero() bool {
+	return p.SessionMessage == "" && !p.SessionRequiredMFA && p.Prompt == "" &&
+		len(p.SessionRequiredIdentities) == 0 && len(p.SessionRequiredSingleDomain) == 0 &&
+		len(p.SessionRequiredPolicies) == 0 && len(p.RequiredScopes) == 0
+}
+
+// Values returns the query parameters of an authorization request that
+// satisfies the parameters. Required scopes are not included; they belong in
+// the scope parameter.
+func (p AuthorizationParameters) Values() url.Values {
+	values := url.Values{}
+	if p.SessionMessage != "" {
+		values.Set("session_message", p.SessionMessage)
+	}
+	if len(p.SessionRequiredIdentities) > 0 {
+		values.Set("session_required_identities", strings.Join(p.SessionRequiredIdentities, ","))
+	}
+	if len(p.SessionRequiredSingleDomain) > 0 {
+		values.Set("session_required_single_domain", strings.Join(p.SessionRequiredSingleDomain, ","))
+	}
+	if p.SessionRequiredMFA {
+		values.Set("session_required_mfa", "true")
+	}
+	if len(p.SessionRequiredPolicies) > 0 {
+		values.Set("session_required_policies", strings.Join(p.SessionRequiredPolicies, ","))
+	}
+	if p.Prompt != "" {
+		values.Set("prompt", p.Prompt)
+	}
+	return values
+}
+
+// AuthorizationParametersError is returned when a Globus service requires the
+// user to log in again, log in with specific identities or MFA, or consent to
+// more scopes before it grants access
+type AuthorizationParametersError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	RequestID  string
+	Parameters AuthorizationParameters
+	RawBody    []byte
+}
+
+// Is reports whether target is core.ErrAuthorizationRequired, or
+// core.ErrConsentRequired when the error requires consent to scopes
+func (e *AuthorizationParametersError) Is(target error) bool {
+	switch target {
+	case core.ErrAuthorizationRequired:
+		return true
+	case core.ErrConsentRequired:
+		return len(e.Parameters.RequiredScopes) > 0 || core.MatchesError(e.StatusCode, e.Code, target)
+	}
+	return false
+}
+
+// HTTPStatus returns the HTTP status code of the response
+func (e *AuthorizationParametersError) HTTPStatus() int {
+	return e.StatusCode
+}
+
+// ErrorCode returns the error code reported by the service
+func (e *AuthorizationParametersError) ErrorCode() string {
+	return e.Code
+}
+
+// ErrorRequestID returns the request ID reported by the service
+func (e *AuthorizationParametersError) ErrorRequestID() string {
+	return e.RequestID
+}
+
+// ResponseBody returns the raw body of the response
+func (e *AuthorizationParametersError) ResponseBody() []byte {
+	return e.RawBody
+}
+
+// Error returns a string representation of the error
+func (e *AuthorizationParametersError) Error() string {
+	var requirements []string
+	if len(e.Parameters.SessionRequiredIdentities) > 0 {
+		requirements = append(requirements, "identities "+strings.Join(e.Parameters.SessionRequiredIdentities, ", "))
+	}
+	if len(e.Parameters.SessionRequiredSingleDomain) > 0 {
+		requirements = append(requirements, "a login from "+strings.Join(e.Parameters.SessionRequiredSingleDomain, " or "))
+	}
+	if e.Parameters.SessionRequiredMFA {
+		requirements = append(requirements, "multi-factor authentication")
+	}
+	if len(e.Parameters.SessionRequiredPolicies) > 0 {
+		requirements = append(requirements, "policies "+strings.Join(e.Parameters.SessionRequiredPolicies, ", "))
+	}
+	if len(e.Parameters.RequiredScopes) > 0 {
+		requirements = append(requirements, "consent to "+strings.Join(e.Parameters.RequiredScopes, " "))
+	}
+
+	message := e.Message
+	if message == "" {
+		message = e.Parameters.SessionMessage
+	}
+	if message == "" {
+		message = "authorization required"
+	}
+	if len(requirements) == 0 {
+		return message
+	}
+	return fmt.Sprintf("%s (requires %s)", message, strings.Join(requirements, "; "))
+}
+
+// authorizationErrorBody is the part of a service error body that can carry
+// authorization parameters, at the top level or in an errors list
+type authorizationErrorBody struct {
+	Code                    string                   `json:"code"`
+	Message                 string                   `json:"message"`
+	RequestID               string                   `json:"request_id"`
+	RequiredScopes          json.RawMessage          `json:"required_scopes"`
+	AuthorizationParameters *AuthorizationParameters `json:"authorization_parameters"`
+	Errors                  []authorizationErrorBody `json:"errors"`
+}
+
+// ParseAuthorizationParameters parses the body of an error response from any
+// Globus service and returns the authorization requirements it carries, or
+// nil if there are none. Parameters are found at the top level, in any entry
+// of an errors list, and in Transfer's ConsentRequired format.
+func ParseAuthorizationParameters(statusCode int, body []byte) *AuthorizationParametersError {
+	var parsed authorizationErrorBody
+	if len(body) == 0 || json.Unmarshal(body, &parsed) != nil {
+		return nil
+	}
+
+	candidates := append([]authorizationErrorBody{parsed}, parsed.Errors...)
+	for _, candidate := range candidates {
+		params := candidate.AuthorizationParameters
+		if params == nil || params.IsZero() {
+			// Transfer lists the scopes of a ConsentRequired error at the top level
+			scopes, _ := decodeStringList(candidate.RequiredScopes)
+			if candidate.Code != "ConsentRequired" || len(scopes) == 0 {
+				continue
+			}
+			params = &AuthorizationParameters{RequiredScopes: scopes}
+		}
+
+		requestID := candidate.RequestID
+		if requestID == "" {
+			requestID = parsed.RequestID
+		}
+		return &AuthorizationParametersError{
+			StatusCode: statusCode,
+			Code:       candidate.Code,
+			Message:    candidate.Message,
+			RequestID:  requestID,
+			Parameters: *params,
+			RawBody:    body,
+		}
+	}
+	return nil
+}
+
+// responseBodyError is implemented by service errors that keep the body of
+// the response that caused them, such as core.Error
+type responseBodyError interface {
+	error
+	ResponseBody() []byte
+}
+
+// statusCodeError is implemented by service errors that record the HTTP
+// status of the response that caused them
+type statusCodeError interface {
+	error
+	HTTPStatus() int
+}
+
+// AsAuthorizationParameters returns the authorization requirements carried
+// by an error returned from any service client, or nil if there are none.
+// MFA required errors from the token endpoint are reported as requiring MFA.
+func AsAuthorizationParameters(err error) *AuthorizationParametersError {
+	if err == nil {
+		return nil
+	}
+
+	var paramsErr *AuthorizationParametersError
+	if errors.As(err, &paramsErr) {
+		return paramsErr
+	}
+
+	var bodyErr responseBodyError
+	if errors.As(err, &bodyErr) {
+		statusCode := 0
+		var statusErr statusCodeError
+		if errors.As(err, &statusErr) {
+			statusCode = statusErr.HTTPStatus()
+		}
+		if parsed := ParseAuthorizationParameters(statusCode, bodyErr.ResponseBody()); parsed != nil {
+			return parsed
+		}
+	}
+
+	var mfaErr *MFARequiredError
+	if errors.As(err, &mfaErr) {
+		message := ""
+		if mfaErr.Response != nil {
+			message = mfaErr.Response.ErrorDescription
+		}
+		return &AuthorizationParametersError{
+			Code:       "mfa_required",
+			Message:    message,
+			Parameters: AuthorizationParameters{SessionRequiredMFA: true},
+		}
+	}
+	return nil
+}
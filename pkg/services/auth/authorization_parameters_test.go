@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+func TestParseAuthorizationParameters(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want *AuthorizationParametersError
+	}{
+		{
+			name: "top level",
+			body: `{"code": "AuthorizationRequired", "message": "Session too old", "request_id": "abc",
+				"authorization_parameters": {"session_message": "Log in again", "session_required_identities": ["id-1"],
+				"session_required_single_domain": ["example.edu"], "session_required_mfa": true, "prompt": "login"}}`,
+			want: &AuthorizationParametersError{
+				StatusCode: http.StatusForbidden,
+				Code:       "AuthorizationRequired",
+				Message:    "Session too old",
+				RequestID:  "abc",
+				Parameters: AuthorizationParameters{
+					SessionMessage:              "Log in again",
+					SessionRequiredIdentities:   []string{"id-1"},
+					SessionRequiredSingleDomain: []string{"example.edu"},
+					SessionRequiredMFA:          true,
+					Prompt:                      "login",
+				},
+			},
+		},
+		{
+			name: "errors list with comma-separated policies",
+			body: `{"request_id": "def", "errors": [{"code": "Other"}, {"code": "AuthenticationFailed", "message": "Policy",
+				"authorization_parameters": {"session_required_policies": "policy-1, policy-2"}}]}`,
+			want: &AuthorizationParametersError{
+				StatusCode: http.StatusForbidden,
+				Code:       "AuthenticationFailed",
+				Message:    "Policy",
+				RequestID:  "def",
+				Parameters: AuthorizationParameters{SessionRequiredPolicies: []string{"policy-1", "policy-2"}},
+			},
+		},
+		{
+			name: "transfer consent required",
+			body: `{"code": "ConsentRequired", "message": "Missing consent", "required_scopes": ["scope-a"]}`,
+			want: &AuthorizationParametersError{
+				StatusCode: http.StatusForbidden,
+				Code:       "ConsentRequired",
+				Message:    "Missing consent",
+				Parameters: AuthorizationParameters{RequiredScopes: []string{"scope-a"}},
+			},
+		},
+		{
+			name: "no parameters",
+			body: `{"code": "PermissionDenied", "message": "No", "authorization_parameters": {}}`,
+		},
+		{
+			name: "not JSON",
+			body: `<html>Forbidden</html>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseAuthorizationParameters(http.StatusForbidden, []byte(tt.body))
+			if tt.want != nil {
+				tt.want.RawBody = []byte(tt.body)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseAuthorizationParameters() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAsAuthorizationParameters(t *testing.T) {
+	coreErr := &core.Error{
+		Code:       "AuthorizationRequired",
+		StatusCode: http.StatusUnauthorized,
+		RawBody:    []byte(`{"errors": [{"code": "AuthorizationRequired", "authorization_parameters": {"session_required_mfa": true}}]}`),
+	}
+
+	params := AsAuthorizationParameters(fmt.Errorf("listing groups: %w", coreErr))
+	if params == nil || !params.Parameters.SessionRequiredMFA || params.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("AsAuthorizationParameters() = %+v", params)
+	}
+	if !strings.Contains(params.Error(), "multi-factor authentication") {
+		t.Errorf("Error() = %q", params.Error())
+	}
+
+	if params := AsAuthorizationParameters(&core.Error{StatusCode: http.StatusNotFound, RawBody: []byte(`{}`)}); params != nil {
+		t.Errorf("AsAuthorizationParameters() = %+v for an error without parameters", params)
+	}
+	if params := AsAuthorizationParameters(&MFARequiredError{Response: &ErrorResponse{Error: "mfa_required"}}); params == nil || !params.Parameters.SessionRequiredMFA {
+		t.Errorf("AsAuthorizationParameters() for MFARequiredError = %+v", params)
+	}
+}
+
+func TestGetAuthorizationURLWithParameters(t *testing.T) {
+	client, _ := NewClient(WithClientID("test-client-id"), WithRedirectURL("https://example.com/callback"))
+
+	scopes := make([]string, 1, 4)
+	scopes[0] = "openid"
+	authURL := client.GetAuthorizationURLWithParameters("state", AuthorizationParameters{
+		SessionRequiredIdentities: []string{"id-1", "id-2"},
+		SessionRequiredMFA:        true,
+		Prompt:                    "login",
+		RequiredScopes:            []string{"openid", "scope-a"},
+	}, scopes...)
+
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+	query := parsed.Query()
+	if query.Get("scope") != "openid scope-a" {
+		t.Errorf("scope = %q, want required scopes added once", query.Get("scope"))
+	}
+	if query.Get("session_required_identities") != "id-1,id-2" || query.Get("session_required_mfa") != "true" || query.Get("prompt") != "login" {
+		t.Errorf("Session parameters = %v", query)
+	}
+	if query.Get("client_id") != "test-client-id" || query.Get("response_type") != "code" {
+		t.Errorf("Standard parameters = %v", query)
+	}
+	if scopes[:2][1] != "" {
+		t.Error("GetAuthorizationURLWithParameters() modified the caller's scopes")
+	}
+}
@@ -30,6 +30,8 @@ type Client struct {
 	ClientID     string
 	ClientSecret string
 	RedirectURL  string
+
+	jwks jwksCache
 }
 
 // NewClient creates a new Auth client
@@ -66,6 +68,22 @@ func (c *Client) SetRedirectURL(redirectURL string) {
 
 // GetAuthorizationURL returns a URL for user authorization
 func (c *Client) GetAuthorizationURL(state string, scopes ...string) string {
+	return c.GetAuthorizationURLWithParameters(state, AuthorizationParameters{}, scopes...)
+}
+
+// GetAuthorizationURLWithParameters returns a URL for user authorization that
+// satisfies the session requirements in params, such as those returned by
+// AsAuthorizationParameters for a failed request. Scopes in
+// params.RequiredScopes are requested in addition to scopes.
+func (c *Client) GetAuthorizationURLWithParameters(state string, params AuthorizationParameters, scopes ...string) string {
+	// Request the required scopes along with the caller's
+	scopes = append([]string(nil), scopes...)
+	for _, required := range params.RequiredScopes {
+		if !containsString(scopes, required) {
+			scopes = append(scopes, required)
+		}
+	}
+
 	// Use default scope if none provided
 	if len(scopes) == 0 {
 		scopes = []string{AuthScope}
@@ -75,7 +93,7 @@ func (c *Client) GetAuthorizationURL(state string, scopes ...string) string {
 	scopesStr := strings.Join(scopes, " ")
 
 	// Build the query parameters
-	query := url.Values{}
+	query := params.Values()
 	query.Set("client_id", c.ClientID)
 	query.Set("redirect_uri", c.RedirectURL)
 	query.Set("scope", scopesStr)
@@ -88,6 +106,16 @@ func (c *Client) GetAuthorizationURL(state string, scopes ...string) string {
 	return authURL
 }
 
+// containsString reports whether values contains value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // ExchangeAuthorizationCode exchanges an authorization code for tokens
 func (c *Client) ExchangeAuthorizationCode(ctx context.Context, code string) (*TokenResponse, error) {
 	if c.RedirectURL == "" {
@@ -150,7 +178,7 @@ func (c *Client) tokenRequest(ctx context.Context, form url.Values) (*TokenRespo
 	// Make the request
 	resp, err := c.Client.Do(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("token request failed: %w", err)
+		return nil, fmt.Errorf("token request failed: %w", asAuthError(err))
 	}
 	defer resp.Body.Close()
 
@@ -174,10 +202,31 @@ func (c *Client) tokenRequest(ctx context.Context, form url.Values) (*TokenRespo
 
 // IntrospectToken gets information about a token
 func (c *Client) IntrospectToken(ctx context.Context, token string) (*TokenInfo, error) {
+	return c.introspectToken(ctx, token, "")
+}
+
+// IntrospectTokenWithIdentitySet gets information about a token, including
+// the IDs of all identities linked to its owner in TokenInfo.IdentitySet
+func (c *Client) IntrospectTokenWithIdentitySet(ctx context.Context, token string) (*TokenInfo, error) {
+	return c.introspectToken(ctx, token, "identity_set")
+}
+
+// IntrospectTokenWithSessionInfo gets information about a token including
+// its identity set and the authentications in the user's current session
+func (c *Client) IntrospectTokenWithSessionInfo(ctx context.Context, token string) (*TokenInfo, error) {
+	return c.introspectToken(ctx, token, "session_info,identity_set")
+}
+
+// introspectToken makes an introspection request, asking for the extra
+// fields in include when it is not empty
+func (c *Client) introspectToken(ctx context.Context, token, include string) (*TokenInfo, error) {
 	// Build the request body
 	form := url.Values{}
 	form.Set("token", token)
 	form.Set("client_id", c.ClientID)
+	if include != "" {
+		form.Set("include", include)
+	}
 
 	// Add client secret if available
 	if c.ClientSecret != "" {
@@ -207,7 +256,7 @@ func (c *Client) IntrospectToken(ctx context.Context, token string) (*TokenInfo,
 	// Make the request
 	resp, err := c.Client.Do(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("introspect request failed: %w", err)
+		return nil, fmt.Errorf("introspect request failed: %w", asAuthError(err))
 	}
 	defer resp.Body.Close()
 
@@ -261,7 +310,7 @@ func (c *Client) RevokeToken(ctx context.Context, token string) error {
 	// Make the request
 	resp, err := c.Client.Do(ctx, req)
 	if err != nil {
-		return fmt.Errorf("revoke request failed: %w", err)
+		return fmt.Errorf("revoke request failed: %w", asAuthError(err))
 	}
 	defer resp.Body.Close()
 
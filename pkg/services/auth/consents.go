@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/scopes"
+)
+
+// Consent is a grant of one scope to one client by an identity. Consents for
+// dependent scopes are recorded separately and linked through DependencyPath.
+type Consent struct {
+	ID                  int64     `json:"id"`
+	ScopeID             string    `json:"scope"`
+	ScopeName           string    `json:"scope_name"`
+	ClientID            string    `json:"client"`
+	EffectiveIdentity   string    `json:"effective_identity"`
+	DependencyPath      []int64   `json:"dependency_path"`
+	Created             time.Time `json:"created"`
+	Updated             time.Time `json:"updated"`
+	LastUsed            time.Time `json:"last_used"`
+	Status              string    `json:"status"`
+	AllowsRefresh       bool      `json:"allows_refresh"`
+	AtomicallyRevocable bool      `json:"atomically_revocable"`
+	AutoApproved        bool      `json:"auto_approved"`
+}
+
+// ParentID returns the ID of the consent this consent depends on, or 0 for a
+// root consent
+func (c Consent) ParentID() int64 {
+	if len(c.DependencyPath) < 2 {
+		return 0
+	}
+	return c.DependencyPath[len(c.DependencyPath)-2]
+}
+
+// ListConsentsOptions controls the consents returned by ListConsents
+type ListConsentsOptions struct {
+	// All includes consents granted to every client, not only the caller's
+	All bool
+}
+
+// ConsentNode is a consent in a ConsentForest with the consents that depend
+// on it
+type ConsentNode struct {
+	Consent
+	Children []*ConsentNode
+}
+
+// ConsentForest arranges an identity's consents into trees, with the consent
+// granted directly to a client at the root and dependent scope grants below
+type ConsentForest struct {
+	// Roots are the consents that do not depend on another consent, ordered
+	// by ID
+	Roots []*ConsentNode
+
+	nodes map[int64]*ConsentNode
+}
+
+// NewConsentForest builds the consent trees from a flat list of consents.
+// Consents whose parent is missing from the list are treated as roots.
+func NewConsentForest(consents []Consent) *ConsentForest {
+	forest := &ConsentForest{nodes: make(map[int64]*ConsentNode, len(consents))}
+	for _, consent := range consents {
+		forest.nodes[consent.ID] = &ConsentNode{Consent: consent}
+	}
+
+	ids := make([]int64, 0, len(forest.nodes))
+	for id := range forest.nodes {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		node := forest.nodes[id]
+		if parent, ok := forest.nodes[node.ParentID()]; ok && node.ParentID() != node.ID {
+			parent.Children = append(parent.Children, node)
+		} else {
+			forest.Roots = append(forest.Roots, node)
+		}
+	}
+	return forest
+}
+
+// Get returns the consent with the given ID, or nil
+func (f *ConsentForest) Get(id int64) *ConsentNode {
+	return f.nodes[id]
+}
+
+// ForClient returns the root consents granted to a client
+func (f *ConsentForest) ForClient(clientID string) []*ConsentNode {
+	var roots []*ConsentNode
+	for _, root := range f.Roots {
+		if root.ClientID == clientID {
+			roots = append(roots, root)
+		}
+	}
+	return roots
+}
+
+// MeetsScopeRequirements reports whether the consents cover a scope and all
+// of its required dependencies. Optional dependencies are not checked.
+func (f *ConsentForest) MeetsScopeRequirements(scope scopes.Scope) bool {
+	for _, root := range f.Roots {
+		if root.meets(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// meets reports whether the tree rooted at n covers scope
+func (n *ConsentNode) meets(scope scopes.Scope) bool {
+	if n.ScopeName != scope.Name || (n.Status != "" && n.Status != "approved") {
+		return false
+	}
+	for _, dependency := range scope.Dependencies {
+		if dependency.Optional {
+			continue
+		}
+		found := false
+		for _, child := range n.Children {
+			if child.meets(dependency) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteTree writes the consent trees as indented text, one consent per line,
+// for debugging consent required errors
+func (f *ConsentForest) WriteTree(w io.Writer) error {
+	var b strings.Builder
+	for _, root := range f.Roots {
+		root.writeTree(&b, "", "")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// String returns the consent trees as written by WriteTree
+func (f *ConsentForest) String() string {
+	var b strings.Builder
+	f.WriteTree(&b)
+	return b.String()
+}
+
+// writeTree writes a node and its children, drawing branches from the given
+// prefixes
+func (n *ConsentNode) writeTree(b *strings.Builder, prefix, childPrefix string) {
+	fmt.Fprintf(b, "%s%s (consent %d, client %s", prefix, n.ScopeName, n.ID, n.ClientID)
+	if n.Status != "" && n.Status != "approved" {
+		fmt.Fprintf(b, ", %s", n.Status)
+	}
+	if n.AllowsRefresh {
+		b.WriteString(", refresh")
+	}
+	b.WriteString(")\n")
+
+	for i, child := range n.Children {
+		if i == len(n.Children)-1 {
+			child.writeTree(b, childPrefix+"└── ", childPrefix+"    ")
+		} else {
+			child.writeTree(b, childPrefix+"├── ", childPrefix+"│   ")
+		}
+	}
+}
+
+// ListConsents lists the consents granted by an identity. The caller needs a
+// token for the view_consents scope (scopes.AuthViewConsents).
+func (c *Client) ListConsents(ctx context.Context, identityID string, options *ListConsentsOptions) ([]Consent, error) {
+	if identityID == "" {
+		return nil, fmt.Errorf("identity ID is required")
+	}
+	query := url.Values{}
+	if options != nil && options.All {
+		query.Set("all", "true")
+	}
+
+	var response struct {
+		Consents []Consent `json:"consents"`
+	}
+	if err := c.doAPIRequest(ctx, http.MethodGet, "api/identities/"+url.PathEscape(identityID)+"/consents", query, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Consents, nil
+}
+
+// GetConsentForest lists the consents granted by an identity and arranges
+// them into trees
+func (c *Client) GetConsentForest(ctx context.Context, identityID string, options *ListConsentsOptions) (*ConsentForest, error) {
+	consents, err := c.ListConsents(ctx, identityID, options)
+	if err != nil {
+		return nil, err
+	}
+	return NewConsentForest(consents), nil
+}
+
+// RevokeConsent revokes one consent. Consents that depend on it are revoked
+// with it.
+func (c *Client) RevokeConsent(ctx context.Context, identityID string, consentID int64) error {
+	if identityID == "" {
+		return fmt.Errorf("identity ID is required")
+	}
+	path := "api/identities/" + url.PathEscape(identityID) + "/consents/" + strconv.FormatInt(consentID, 10)
+	return c.doAPIRequest(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// RevokeClientConsents revokes every consent an identity has granted to a
+// client and returns the number of root consents revoked
+func (c *Client) RevokeClientConsents(ctx context.Context, identityID, clientID string) (int, error) {
+	if clientID == "" {
+		return 0, fmt.Errorf("client ID is required")
+	}
+	forest, err := c.GetConsentForest(ctx, identityID, &ListConsentsOptions{All: true})
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := 0
+	for _, root := range forest.ForClient(clientID) {
+		if err := c.RevokeConsent(ctx, identityID, root.ID); err != nil {
+			return revoked, fmt.Errorf("failed to revoke consent %d: %w", root.ID, err)
+		}
+		revoked++
+	}
+	return revoked, nil
+}
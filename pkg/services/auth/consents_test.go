@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/scopes"
+)
+
+const consentsFixture = `{"consents": [
+	{"id": 3, "scope_name": "https://auth.globus.org/scopes/coll-1/data_access", "client": "transfer-client",
+	 "dependency_path": [1, 2, 3], "status": "approved", "allows_refresh": true},
+	{"id": 1, "scope_name": "https://auth.globus.org/scopes/flow-1/flow_flow_1_user", "client": "pipeline",
+	 "dependency_path": [1], "status": "approved", "created": "2025-01-02T03:04:05.000000+00:00", "last_used": null},
+	{"id": 2, "scope_name": "urn:globus:auth:scope:transfer.api.globus.org:all", "client": "flow-1",
+	 "dependency_path": [1, 2], "status": "approved", "allows_refresh": true},
+	{"id": 4, "scope_name": "urn:globus:auth:scope:groups.api.globus.org:all", "client": "flow-1",
+	 "dependency_path": [1, 4], "status": "approved"},
+	{"id": 5, "scope_name": "openid", "client": "other-app", "dependency_path": [5], "status": "approved"}
+]}`
+
+func TestConsentForest(t *testing.T) {
+	var revoked []string
+	server, client := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/identities/user-1/consents":
+			if r.URL.Query().Get("all") != "true" {
+				t.Errorf("all = %q, want true", r.URL.Query().Get("all"))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(consentsFixture))
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/identities/user-1/consents/"):
+			revoked = append(revoked, strings.TrimPrefix(r.URL.Path, "/api/identities/user-1/consents/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	defer server.Close()
+	ctx := context.Background()
+
+	forest, err := client.GetConsentForest(ctx, "user-1", &ListConsentsOptions{All: true})
+	if err != nil {
+		t.Fatalf("GetConsentForest() error = %v", err)
+	}
+	if len(forest.Roots) != 2 || forest.Roots[0].ID != 1 || len(forest.Roots[0].Children) != 2 {
+		t.Fatalf("Roots = %+v", forest.Roots)
+	}
+	if node := forest.Get(3); node == nil || node.ParentID() != 2 || forest.Get(2).Children[0] != node {
+		t.Errorf("Get(3) = %+v", node)
+	}
+	if forest.Get(1).Created.Year() != 2025 || !forest.Get(1).LastUsed.IsZero() {
+		t.Errorf("Timestamps = %v, %v", forest.Get(1).Created, forest.Get(1).LastUsed)
+	}
+
+	want := `https://auth.globus.org/scopes/flow-1/flow_flow_1_user (consent 1, client pipeline)
+├── urn:globus:auth:scope:transfer.api.globus.org:all (consent 2, client flow-1, refresh)
+│   └── https://auth.globus.org/scopes/coll-1/data_access (consent 3, client transfer-client, refresh)
+└── urn:globus:auth:scope:groups.api.globus.org:all (consent 4, client flow-1)
+openid (consent 5, client other-app)
+`
+	if got := forest.String(); got != want {
+		t.Errorf("String() =\n%s\nwant\n%s", got, want)
+	}
+
+	flow := scopes.New("https://auth.globus.org/scopes/flow-1/flow_flow_1_user",
+		scopes.New(scopes.TransferAll, scopes.New("https://auth.globus.org/scopes/coll-1/data_access")),
+		scopes.New(scopes.SearchAll).AsOptional(),
+	)
+	if !forest.MeetsScopeRequirements(flow) {
+		t.Error("MeetsScopeRequirements() = false for a granted tree")
+	}
+	missing := scopes.New(flow.Name, scopes.New(scopes.TransferAll, scopes.New("https://auth.globus.org/scopes/coll-2/data_access")))
+	if forest.MeetsScopeRequirements(missing) {
+		t.Error("MeetsScopeRequirements() = true with a missing collection consent")
+	}
+
+	count, err := client.RevokeClientConsents(ctx, "user-1", "pipeline")
+	if err != nil || count != 1 {
+		t.Fatalf("RevokeClientConsents() = %d, %v", count, err)
+	}
+	if len(revoked) != 1 || revoked[0] != "1" {
+		t.Errorf("Revoked consents %v, want [1]", revoked)
+	}
+}
@@ -0,0 +1,408 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+// The developer API methods in this file manage projects, clients, client
+// credentials and scopes. They require a client created with an authorizer
+// holding a token for the auth.globus.org manage_projects scope
+// (scopes.AuthManageProjects).
+
+// projectEnvelope wraps a single project in responses
+type projectEnvelope struct {
+	Project *Project `json:"project"`
+}
+
+// clientEnvelope wraps a single client in responses
+type clientEnvelope struct {
+	Client *OAuthClient `json:"client"`
+}
+
+// credentialEnvelope wraps a single credential in responses
+type credentialEnvelope struct {
+	Credential *ClientCredential `json:"credential"`
+}
+
+// scopeEnvelope wraps a single scope in responses
+type scopeEnvelope struct {
+	Scope *ClientScope `json:"scope"`
+}
+
+// scopesEnvelope wraps a list of scopes in responses
+type scopesEnvelope struct {
+	Scopes []ClientScope `json:"scopes"`
+}
+
+// developerError parses an error response from the developer API into an
+// *AuthError. The developer API reports errors as a list of objects with a
+// code and detail rather than as an OAuth error. The body is kept on the
+// error but not included in its message.
+func developerError(statusCode int, requestID string, respBody []byte) *AuthError {
+	authErr := &AuthError{
+		StatusCode: statusCode,
+		RequestID:  requestID,
+		RawBody:    respBody,
+	}
+
+	var response struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+		Errors           []struct {
+			Code   string `json:"code"`
+			Detail string `json:"detail"`
+		} `json:"errors"`
+	}
+	if json.Unmarshal(respBody, &response) != nil {
+		return authErr
+	}
+	authErr.Code, authErr.Description = response.Error, response.ErrorDescription
+	if len(response.Errors) > 0 {
+		if authErr.Code == "" {
+			authErr.Code = response.Errors[0].Code
+		}
+		if authErr.Description == "" {
+			authErr.Description = response.Errors[0].Detail
+		}
+	}
+	return authErr
+}
+
+// doAPIRequest sends a JSON request to the developer API and decodes the
+// JSON response into response, if it is not nil
+func (c *Client) doAPIRequest(ctx context.Context, method, path string, query url.Values, body, response interface{}) error {
+	requestURL := c.Client.BaseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyJSON, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(bodyJSON)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, bodyReader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.Client.Do(ctx, req)
+	if err != nil {
+		var coreErr *core.Error
+		if errors.As(err, &coreErr) {
+			err = developerError(coreErr.StatusCode, coreErr.RequestID, coreErr.RawBody)
+		}
+		return fmt.Errorf("%s %s failed: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s %s failed: %w", method, path,
+			developerError(resp.StatusCode, resp.Header.Get("X-Request-Id"), respBody))
+	}
+	if response == nil || len(respBody) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, response); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return nil
+}
+
+// ListProjects lists the projects the caller administers
+func (c *Client) ListProjects(ctx context.Context) ([]Project, error) {
+	var response struct {
+		Projects []Project `json:"projects"`
+	}
+	if err := c.doAPIRequest(ctx, http.MethodGet, "api/projects", nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Projects, nil
+}
+
+// GetProject retrieves a project by ID
+func (c *Client) GetProject(ctx context.Context, projectID string) (*Project, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	return c.projectRequest(ctx, http.MethodGet, "api/projects/"+url.PathEscape(projectID), nil)
+}
+
+// CreateProject creates a project
+func (c *Client) CreateProject(ctx context.Context, project *ProjectCreate) (*Project, error) {
+	if project == nil || project.DisplayName == "" {
+		return nil, fmt.Errorf("project display name is required")
+	}
+	if project.ContactEmail == "" {
+		return nil, fmt.Errorf("project contact email is required")
+	}
+	if len(project.AdminIDs) == 0 && len(project.AdminGroupIDs) == 0 {
+		return nil, fmt.Errorf("at least one project admin identity or group is required")
+	}
+	return c.projectRequest(ctx, http.MethodPost, "api/projects", map[string]interface{}{"project": project})
+}
+
+// UpdateProject changes the fields of a project that are set in update
+func (c *Client) UpdateProject(ctx context.Context, projectID string, update *ProjectUpdate) (*Project, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if update == nil {
+		return nil, fmt.Errorf("project update is required")
+	}
+	return c.projectRequest(ctx, http.MethodPut, "api/projects/"+url.PathEscape(projectID), map[string]interface{}{"project": update})
+}
+
+// DeleteProject deletes a project. The project must not have any clients.
+func (c *Client) DeleteProject(ctx context.Context, projectID string) error {
+	if projectID == "" {
+		return fmt.Errorf("project ID is required")
+	}
+	return c.doAPIRequest(ctx, http.MethodDelete, "api/projects/"+url.PathEscape(projectID), nil, nil, nil)
+}
+
+// projectRequest sends a request whose response is a single project
+func (c *Client) projectRequest(ctx context.Context, method, path string, body interface{}) (*Project, error) {
+	var response projectEnvelope
+	if err := c.doAPIRequest(ctx, method, path, nil, body, &response); err != nil {
+		return nil, err
+	}
+	if response.Project == nil {
+		return nil, fmt.Errorf("response did not include a project")
+	}
+	return response.Project, nil
+}
+
+// ListClients lists the clients in the projects the caller administers
+func (c *Client) ListClients(ctx context.Context) ([]OAuthClient, error) {
+	var response struct {
+		Clients []OAuthClient `json:"clients"`
+	}
+	if err := c.doAPIRequest(ctx, http.MethodGet, "api/clients", nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Clients, nil
+}
+
+// GetClient retrieves a client by ID
+func (c *Client) GetClient(ctx context.Context, clientID string) (*OAuthClient, error) {
+	if clientID == "" {
+		return nil, fmt.Errorf("client ID is required")
+	}
+	return c.clientRequest(ctx, http.MethodGet, "api/clients/"+url.PathEscape(clientID), nil)
+}
+
+// CreateClient registers a client in a project
+func (c *Client) CreateClient(ctx context.Context, client *OAuthClientCreate) (*OAuthClient, error) {
+	if client == nil || client.Name == "" {
+		return nil, fmt.Errorf("client name is required")
+	}
+	if client.ProjectID == "" {
+		return nil, fmt.Errorf("client project ID is required")
+	}
+	return c.clientRequest(ctx, http.MethodPost, "api/clients", map[string]interface{}{"client": client})
+}
+
+// UpdateClient changes the fields of a client that are set in update
+func (c *Client) UpdateClient(ctx context.Context, clientID string, update *OAuthClientUpdate) (*OAuthClient, error) {
+	if clientID == "" {
+		return nil, fmt.Errorf("client ID is required")
+	}
+	if update == nil {
+		return nil, fmt.Errorf("client update is required")
+	}
+	return c.clientRequest(ctx, http.MethodPut, "api/clients/"+url.PathEscape(clientID), map[string]interface{}{"client": update})
+}
+
+// DeleteClient deletes a client along with its credentials and scopes
+func (c *Client) DeleteClient(ctx context.Context, clientID string) error {
+	if clientID == "" {
+		return fmt.Errorf("client ID is required")
+	}
+	return c.doAPIRequest(ctx, http.MethodDelete, "api/clients/"+url.PathEscape(clientID), nil, nil, nil)
+}
+
+// clientRequest sends a request whose response is a single client
+func (c *Client) clientRequest(ctx context.Context, method, path string, body interface{}) (*OAuthClient, error) {
+	var response clientEnvelope
+	if err := c.doAPIRequest(ctx, method, path, nil, body, &response); err != nil {
+		return nil, err
+	}
+	if response.Client == nil {
+		return nil, fmt.Errorf("response did not include a client")
+	}
+	return response.Client, nil
+}
+
+// CreateServiceAccount registers a client identity in a project and creates
+// its first credential. Use the returned client ID and credential secret with
+// WithClientID and WithClientSecret to obtain client credentials tokens.
+func (c *Client) CreateServiceAccount(ctx context.Context, projectID, name string) (*OAuthClient, *ClientCredential, error) {
+	client, err := c.CreateClient(ctx, &OAuthClientCreate{
+		Name:       name,
+		ProjectID:  projectID,
+		ClientType: ClientTypeClientIdentity,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create service account: %w", err)
+	}
+
+	credential, err := c.CreateClientCredential(ctx, client.ID, name)
+	if err != nil {
+		return client, nil, fmt.Errorf("failed to create credential for service account %s: %w", client.ID, err)
+	}
+	return client, credential, nil
+}
+
+// ListClientCredentials lists the credentials of a client. Secrets are not
+// included.
+func (c *Client) ListClientCredentials(ctx context.Context, clientID string) ([]ClientCredential, error) {
+	if clientID == "" {
+		return nil, fmt.Errorf("client ID is required")
+	}
+	var response struct {
+		Credentials []ClientCredential `json:"credentials"`
+	}
+	if err := c.doAPIRequest(ctx, http.MethodGet, "api/clients/"+url.PathEscape(clientID)+"/credentials", nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Credentials, nil
+}
+
+// CreateClientCredential creates a credential for a client. The returned
+// secret cannot be retrieved again.
+func (c *Client) CreateClientCredential(ctx context.Context, clientID, name string) (*ClientCredential, error) {
+	if clientID == "" {
+		return nil, fmt.Errorf("client ID is required")
+	}
+	if name == "" {
+		return nil, fmt.Errorf("credential name is required")
+	}
+
+	body := map[string]interface{}{"credential": map[string]string{"name": name}}
+	var response credentialEnvelope
+	if err := c.doAPIRequest(ctx, http.MethodPost, "api/clients/"+url.PathEscape(clientID)+"/credentials", nil, body, &response); err != nil {
+		return nil, err
+	}
+	if response.Credential == nil {
+		return nil, fmt.Errorf("response did not include a credential")
+	}
+	return response.Credential, nil
+}
+
+// DeleteClientCredential deletes a credential from a client
+func (c *Client) DeleteClientCredential(ctx context.Context, clientID, credentialID string) error {
+	if clientID == "" || credentialID == "" {
+		return fmt.Errorf("client ID and credential ID are required")
+	}
+	path := "api/clients/" + url.PathEscape(clientID) + "/credentials/" + url.PathEscape(credentialID)
+	return c.doAPIRequest(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// ListScopes lists scopes. Without options it lists the scopes of the
+// clients the caller administers.
+func (c *Client) ListScopes(ctx context.Context, options *ListScopesOptions) ([]ClientScope, error) {
+	query := url.Values{}
+	if options != nil {
+		if len(options.IDs) > 0 {
+			query.Set("ids", strings.Join(options.IDs, ","))
+		}
+		if len(options.ScopeStrings) > 0 {
+			query.Set("scope_strings", strings.Join(options.ScopeStrings, ","))
+		}
+	}
+
+	var response scopesEnvelope
+	if err := c.doAPIRequest(ctx, http.MethodGet, "api/scopes", query, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Scopes, nil
+}
+
+// GetScope retrieves a scope by ID
+func (c *Client) GetScope(ctx context.Context, scopeID string) (*ClientScope, error) {
+	if scopeID == "" {
+		return nil, fmt.Errorf("scope ID is required")
+	}
+	return c.scopeRequest(ctx, http.MethodGet, "api/scopes/"+url.PathEscape(scopeID), nil)
+}
+
+// CreateScope creates a scope on a client. The client must be able to act as
+// a resource server.
+func (c *Client) CreateScope(ctx context.Context, clientID string, scope *ClientScopeCreate) (*ClientScope, error) {
+	if clientID == "" {
+		return nil, fmt.Errorf("client ID is required")
+	}
+	if scope == nil || scope.Name == "" || scope.ScopeSuffix == "" {
+		return nil, fmt.Errorf("scope name and suffix are required")
+	}
+	if scope.Description == "" {
+		return nil, fmt.Errorf("scope description is required")
+	}
+
+	// Creating a scope responds with a list holding the new scope
+	body := map[string]interface{}{"scope": scope}
+	var response scopesEnvelope
+	if err := c.doAPIRequest(ctx, http.MethodPost, "api/clients/"+url.PathEscape(clientID)+"/scopes", nil, body, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Scopes) == 0 {
+		return nil, fmt.Errorf("response did not include a scope")
+	}
+	return &response.Scopes[0], nil
+}
+
+// UpdateScope changes the fields of a scope that are set in update
+func (c *Client) UpdateScope(ctx context.Context, scopeID string, update *ClientScopeUpdate) (*ClientScope, error) {
+	if scopeID == "" {
+		return nil, fmt.Errorf("scope ID is required")
+	}
+	if update == nil {
+		return nil, fmt.Errorf("scope update is required")
+	}
+	return c.scopeRequest(ctx, http.MethodPut, "api/scopes/"+url.PathEscape(scopeID), map[string]interface{}{"scope": update})
+}
+
+// DeleteScope deletes a scope
+func (c *Client) DeleteScope(ctx context.Context, scopeID string) error {
+	if scopeID == "" {
+		return fmt.Errorf("scope ID is required")
+	}
+	return c.doAPIRequest(ctx, http.MethodDelete, "api/scopes/"+url.PathEscape(scopeID), nil, nil, nil)
+}
+
+// scopeRequest sends a request whose response is a single scope
+func (c *Client) scopeRequest(ctx context.Context, method, path string, body interface{}) (*ClientScope, error) {
+	var response scopeEnvelope
+	if err := c.doAPIRequest(ctx, method, path, nil, body, &response); err != nil {
+		return nil, err
+	}
+	if response.Scope == nil {
+		return nil, fmt.Errorf("response did not include a scope")
+	}
+	return response.Scope, nil
+}
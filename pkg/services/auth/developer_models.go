@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import "time"
+
+// Client types accepted by CreateClient
+const (
+	ClientTypeClientIdentity           = "client_identity"
+	ClientTypeConfidentialClient       = "confidential_client"
+	ClientTypePublicInstalledClient    = "public_installed_client"
+	ClientTypeResourceServer           = "resource_server"
+	ClientTypeHybridConfidentialClient = "hybrid_confidential_client_resource_server"
+)
+
+// Project is a Globus Auth project, which groups clients under a set of
+// administrators
+type Project struct {
+	ID            string         `json:"id"`
+	DisplayName   string         `json:"display_name"`
+	ProjectName   string         `json:"project_name,omitempty"`
+	ContactEmail  string         `json:"contact_email"`
+	AdminIDs      []string       `json:"admin_ids"`
+	AdminGroupIDs []string       `json:"admin_group_ids"`
+	Admins        *ProjectAdmins `json:"admins,omitempty"`
+}
+
+// ProjectAdmins lists the identities and groups that administer a project
+type ProjectAdmins struct {
+	Identities []ProjectAdminIdentity `json:"identities"`
+	Groups     []ProjectAdminGroup    `json:"groups"`
+}
+
+// ProjectAdminIdentity is an identity that administers a project
+type ProjectAdminIdentity struct {
+	ID               string `json:"id"`
+	Username         string `json:"username"`
+	Name             string `json:"name,omitempty"`
+	Email            string `json:"email,omitempty"`
+	IdentityProvider string `json:"identity_provider,omitempty"`
+}
+
+// ProjectAdminGroup is a group whose members administer a project
+type ProjectAdminGroup struct {
+	ID string `json:"id"`
+}
+
+// ProjectCreate holds the fields for creating or updating a project. At
+// least one of AdminIDs and AdminGroupIDs is required on create.
+type ProjectCreate struct {
+	DisplayName   string   `json:"display_name,omitempty"`
+	ContactEmail  string   `json:"contact_email,omitempty"`
+	AdminIDs      []string `json:"admin_ids,omitempty"`
+	AdminGroupIDs []string `json:"admin_group_ids,omitempty"`
+}
+
+// ProjectUpdate holds the fields to change on a project; empty fields are
+// left unchanged
+type ProjectUpdate = ProjectCreate
+
+// ClientLinks holds the policy links shown to users on the consent page
+type ClientLinks struct {
+	TermsAndConditions string `json:"terms_and_conditions,omitempty"`
+	PrivacyPolicy      string `json:"privacy_policy,omitempty"`
+}
+
+// OAuthClient is a client registered with Globus Auth. It is named to avoid
+// confusion with Client, the API client in this package.
+type OAuthClient struct {
+	ID                            string      `json:"id"`
+	Name                          string      `json:"name"`
+	ProjectID                     string      `json:"project"`
+	ClientType                    string      `json:"client_type"`
+	PublicClient                  bool        `json:"public_client"`
+	RedirectURIs                  []string    `json:"redirect_uris"`
+	GrantTypes                    []string    `json:"grant_types"`
+	Scopes                        []string    `json:"scopes"`
+	FQDNs                         []string    `json:"fqdns"`
+	Visibility                    string      `json:"visibility"`
+	RequiredIDP                   string      `json:"required_idp,omitempty"`
+	PreselectIDP                  string      `json:"preselect_idp,omitempty"`
+	ParentClient                  string      `json:"parent_client,omitempty"`
+	UserinfoFromEffectiveIdentity bool        `json:"userinfo_from_effective_identity"`
+	Links                         ClientLinks `json:"links"`
+}
+
+// OAuthClientCreate holds the fields for creating a client. Name and
+// ProjectID are required; ClientType defaults to a confidential client on
+// the server.
+type OAuthClientCreate struct {
+	Name                          string       `json:"name"`
+	ProjectID                     string       `json:"project,omitempty"`
+	ClientType                    string       `json:"client_type,omitempty"`
+	PublicClient                  *bool        `json:"public_client,omitempty"`
+	RedirectURIs                  []string     `json:"redirect_uris,omitempty"`
+	Visibility                    string       `json:"visibility,omitempty"`
+	RequiredIDP                   string       `json:"required_idp,omitempty"`
+	PreselectIDP                  string       `json:"preselect_idp,omitempty"`
+	UserinfoFromEffectiveIdentity *bool        `json:"userinfo_from_effective_identity,omitempty"`
+	Links                         *ClientLinks `json:"links,omitempty"`
+}
+
+// OAuthClientUpdate holds the fields to change on a client; empty fields are
+// left unchanged
+type OAuthClientUpdate struct {
+	Name                          string       `json:"name,omitempty"`
+	RedirectURIs                  []string     `json:"redirect_uris,omitempty"`
+	Visibility                    string       `json:"visibility,omitempty"`
+	RequiredIDP                   string       `json:"required_idp,omitempty"`
+	PreselectIDP                  string       `json:"preselect_idp,omitempty"`
+	UserinfoFromEffectiveIdentity *bool        `json:"userinfo_from_effective_identity,omitempty"`
+	Links                         *ClientLinks `json:"links,omitempty"`
+}
+
+// ClientCredential is a secret that a client uses to authenticate. Secret is
+// only returned when the credential is created.
+type ClientCredential struct {
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	ClientID string    `json:"client"`
+	Secret   string    `json:"secret,omitempty"`
+	Created  time.Time `json:"created"`
+}
+
+// DependentScope is a scope that is requested on behalf of the user when a
+// parent scope is granted
+type DependentScope struct {
+	ScopeID              string `json:"scope"`
+	Optional             bool   `json:"optional"`
+	RequiresRefreshToken bool   `json:"requires_refresh_token"`
+}
+
+// ClientScope is a scope defined by a client acting as a resource server
+type ClientScope struct {
+	ID                 string           `json:"id"`
+	ClientID           string           `json:"client"`
+	Name               string           `json:"name"`
+	Description        string           `json:"description"`
+	ScopeString        string           `json:"scope_string"`
+	DependentScopes    []DependentScope `json:"dependent_scopes"`
+	Advertised         bool             `json:"advertised"`
+	AllowsRefreshToken bool             `json:"allows_refresh_token"`
+	RequiredDomains    []string         `json:"required_domains"`
+}
+
+// ClientScopeCreate holds the fields for creating a scope. ScopeSuffix is
+// appended to the client's scope prefix to form the scope string.
+type ClientScopeCreate struct {
+	Name               string           `json:"name"`
+	Description        string           `json:"description"`
+	ScopeSuffix        string           `json:"scope_suffix"`
+	DependentScopes    []DependentScope `json:"dependent_scopes,omitempty"`
+	Advertised         *bool            `json:"advertised,omitempty"`
+	AllowsRefreshToken *bool            `json:"allows_refresh_token,omitempty"`
+	RequiredDomains    []string         `json:"required_domains,omitempty"`
+}
+
+// ClientScopeUpdate holds the fields to change on a scope; empty fields are
+// left unchanged. DependentScopes replaces the existing list when set.
+type ClientScopeUpdate struct {
+	Name               string           `json:"name,omitempty"`
+	Description        string           `json:"description,omitempty"`
+	DependentScopes    []DependentScope `json:"dependent_scopes,omitempty"`
+	Advertised         *bool            `json:"advertised,omitempty"`
+	AllowsRefreshToken *bool            `json:"allows_refresh_token,omitempty"`
+	RequiredDomains    []string         `json:"required_domains,omitempty"`
+}
+
+// ListScopesOptions filters the scopes returned by ListScopes
+type ListScopesOptions struct {
+	// IDs limits the result to the scopes with these IDs
+	IDs []string
+
+	// ScopeStrings limits the result to the scopes with these scope strings
+	ScopeStrings []string
+}
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+func TestDeveloperProjects(t *testing.T) {
+	var created map[string]ProjectCreate
+	server, client := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/projects":
+			w.Write([]byte(`{"projects": [{"id": "p1", "display_name": "One", "admin_ids": ["a1"]}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/projects":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.Write([]byte(`{"project": {"id": "p2", "display_name": "Two", "contact_email": "x@example.org",
+				"admins": {"identities": [{"id": "a1", "username": "a@example.org"}], "groups": []}}}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/projects/p2":
+			w.Write([]byte(`{"project": {"id": "p2"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/projects/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors": [{"code": "NOT_FOUND", "detail": "no such project"}]}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	defer server.Close()
+	ctx := context.Background()
+
+	projects, err := client.ListProjects(ctx)
+	if err != nil || len(projects) != 1 || projects[0].ID != "p1" || projects[0].AdminIDs[0] != "a1" {
+		t.Fatalf("ListProjects() = %+v, %v", projects, err)
+	}
+
+	if _, err := client.CreateProject(ctx, &ProjectCreate{DisplayName: "Two", ContactEmail: "x@example.org"}); err == nil {
+		t.Error("CreateProject() accepted a project without admins")
+	}
+	project, err := client.CreateProject(ctx, &ProjectCreate{DisplayName: "Two", ContactEmail: "x@example.org", AdminIDs: []string{"a1"}})
+	if err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	if project.ID != "p2" || project.Admins == nil || project.Admins.Identities[0].Username != "a@example.org" {
+		t.Errorf("CreateProject() = %+v", project)
+	}
+	if created["project"].DisplayName != "Two" || created["project"].AdminIDs[0] != "a1" {
+		t.Errorf("CreateProject() sent %+v", created)
+	}
+
+	if err := client.DeleteProject(ctx, "p2"); err != nil {
+		t.Errorf("DeleteProject() error = %v", err)
+	}
+
+	_, err = client.GetProject(ctx, "missing")
+	var authErr *AuthError
+	if !errors.As(err, &authErr) || authErr.Code != "NOT_FOUND" || authErr.Description != "no such project" {
+		t.Errorf("GetProject() error = %v, want a NOT_FOUND AuthError", err)
+	}
+	if !errors.Is(err, core.ErrNotFound) {
+		t.Errorf("GetProject() error = %v, want core.ErrNotFound", err)
+	}
+	if strings.Contains(err.Error(), "{") {
+		t.Errorf("GetProject() error message %q includes the response body", err.Error())
+	}
+}
+
+func TestDeveloperClientsAndCredentials(t *testing.T) {
+	var requests []string
+	var createdClient map[string]map[string]interface{}
+	server, client := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/clients":
+			json.NewDecoder(r.Body).Decode(&createdClient)
+			w.Write([]byte(`{"client": {"id": "c1", "name": "robot", "project": "p1", "client_type": "client_identity"}}`))
+		case "POST /api/clients/c1/credentials":
+			w.Write([]byte(`{"credential": {"id": "cred1", "name": "robot", "client": "c1", "secret": "s3cret",
+				"created": "2025-01-02T03:04:05.000000+00:00"}}`))
+		case "GET /api/clients/c1/credentials":
+			w.Write([]byte(`{"credentials": [{"id": "cred1", "name": "robot", "client": "c1"}]}`))
+		case "DELETE /api/clients/c1/credentials/cred1":
+			w.Write([]byte(`{"credential": {"id": "cred1"}}`))
+		case "PUT /api/clients/c1":
+			w.Write([]byte(`{"client": {"id": "c1", "name": "renamed", "project": "p1"}}`))
+		case "GET /api/clients":
+			w.Write([]byte(`{"clients": [{"id": "c1", "name": "renamed"}]}`))
+		case "DELETE /api/clients/c1":
+			w.Write([]byte(`{"client": {"id": "c1"}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	defer server.Close()
+	ctx := context.Background()
+
+	account, credential, err := client.CreateServiceAccount(ctx, "p1", "robot")
+	if err != nil {
+		t.Fatalf("CreateServiceAccount() error = %v", err)
+	}
+	if account.ID != "c1" || credential.Secret != "s3cret" || credential.Created.Year() != 2025 {
+		t.Errorf("CreateServiceAccount() = %+v, %+v", account, credential)
+	}
+	if createdClient["client"]["client_type"] != ClientTypeClientIdentity || createdClient["client"]["project"] != "p1" {
+		t.Errorf("CreateServiceAccount() sent %v", createdClient)
+	}
+
+	credentials, err := client.ListClientCredentials(ctx, "c1")
+	if err != nil || len(credentials) != 1 || credentials[0].Secret != "" {
+		t.Errorf("ListClientCredentials() = %+v, %v", credentials, err)
+	}
+	if err := client.DeleteClientCredential(ctx, "c1", "cred1"); err != nil {
+		t.Errorf("DeleteClientCredential() error = %v", err)
+	}
+
+	updated, err := client.UpdateClient(ctx, "c1", &OAuthClientUpdate{Name: "renamed"})
+	if err != nil || updated.Name != "renamed" {
+		t.Errorf("UpdateClient() = %+v, %v", updated, err)
+	}
+	clients, err := client.ListClients(ctx)
+	if err != nil || len(clients) != 1 {
+		t.Errorf("ListClients() = %+v, %v", clients, err)
+	}
+	if err := client.DeleteClient(ctx, "c1"); err != nil {
+		t.Errorf("DeleteClient() error = %v", err)
+	}
+
+	if _, err := client.CreateClient(ctx, &OAuthClientCreate{Name: "no project"}); err == nil {
+		t.Error("CreateClient() accepted a client without a project")
+	}
+	if len(requests) != 7 {
+		t.Errorf("Sent %d requests, want 7: %v", len(requests), requests)
+	}
+}
+
+func TestDeveloperScopes(t *testing.T) {
+	var createdScope map[string]ClientScopeCreate
+	server, client := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/clients/c1/scopes":
+			json.NewDecoder(r.Body).Decode(&createdScope)
+			w.Write([]byte(`{"scopes": [{"id": "s1", "client": "c1", "name": "All",
+				"scope_string": "https://auth.globus.org/scopes/c1/all",
+				"dependent_scopes": [{"scope": "t1", "optional": false, "requires_refresh_token": true}]}]}`))
+		case "GET /api/scopes":
+			if got := r.URL.Query().Get("scope_strings"); got != "a,b" {
+				t.Errorf("scope_strings = %q, want a,b", got)
+			}
+			w.Write([]byte(`{"scopes": [{"id": "s1"}, {"id": "s2"}]}`))
+		case "GET /api/scopes/s1":
+			w.Write([]byte(`{"scope": {"id": "s1", "advertised": true}}`))
+		case "PUT /api/scopes/s1":
+			w.Write([]byte(`{"scope": {"id": "s1", "description": "updated"}}`))
+		case "DELETE /api/scopes/s1":
+			w.Write([]byte(`{"scope": {"id": "s1"}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	defer server.Close()
+	ctx := context.Background()
+
+	refresh := true
+	scope, err := client.CreateScope(ctx, "c1", &ClientScopeCreate{
+		Name:               "All",
+		Description:        "Everything",
+		ScopeSuffix:        "all",
+		AllowsRefreshToken: &refresh,
+		DependentScopes:    []DependentScope{{ScopeID: "t1", RequiresRefreshToken: true}},
+	})
+	if err != nil {
+		t.Fatalf("CreateScope() error = %v", err)
+	}
+	if scope.ScopeString != "https://auth.globus.org/scopes/c1/all" || !scope.DependentScopes[0].RequiresRefreshToken {
+		t.Errorf("CreateScope() = %+v", scope)
+	}
+	if sent := createdScope["scope"]; sent.ScopeSuffix != "all" || sent.AllowsRefreshToken == nil || sent.DependentScopes[0].ScopeID != "t1" {
+		t.Errorf("CreateScope() sent %+v", sent)
+	}
+
+	scopes, err := client.ListScopes(ctx, &ListScopesOptions{ScopeStrings: []string{"a", "b"}})
+	if err != nil || len(scopes) != 2 {
+		t.Errorf("ListScopes() = %+v, %v", scopes, err)
+	}
+	if scope, err := client.GetScope(ctx, "s1"); err != nil || !scope.Advertised {
+		t.Errorf("GetScope() = %+v, %v", scope, err)
+	}
+	if scope, err := client.UpdateScope(ctx, "s1", &ClientScopeUpdate{Description: "updated"}); err != nil || scope.Description != "updated" {
+		t.Errorf("UpdateScope() = %+v, %v", scope, err)
+	}
+	if err := client.DeleteScope(ctx, "s1"); err != nil {
+		t.Errorf("DeleteScope() error = %v", err)
+	}
+}
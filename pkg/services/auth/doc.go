@@ -118,5 +118,55 @@ Creating authorizers:
 	if err != nil {
 		// Handle error
 	}
+
+Verifying ID tokens returned when the openid scope is requested:
+
+	claims, err := authClient.VerifyIDToken(ctx, tokenResponse.IDToken, auth.WithNonce(nonce))
+	if err != nil {
+		// Do not trust the identity claims
+	}
+	fmt.Println(claims.Subject, claims.PreferredUsername)
+
+Sending users back to log in when any service requires a fresh or stronger
+session:
+
+	if params := auth.AsAuthorizationParameters(err); params != nil {
+		loginURL := authClient.GetAuthorizationURLWithParameters(state, params.Parameters, scopes...)
+		// Redirect the user to loginURL
+	}
+
+Protecting a resource server's handlers with token introspection:
+
+	middleware := auth.NewIntrospectionMiddleware(resourceServerClient,
+		auth.RequireScopes("https://auth.globus.org/scopes/<client-id>/all"),
+		auth.RequireAudiences("<client-id>"),
+	)
+	http.Handle("/api/", middleware.Handler(apiHandler))
+
+	// In apiHandler
+	info, _ := auth.TokenInfoFromContext(r.Context())
+
+Managing projects, clients and scopes with the developer API, using a token
+for the manage_projects scope:
+
+	developer, _ := auth.NewClient(auth.WithCoreOption(
+		core.WithAuthorizer(authorizers.StaticTokenCoreAuthorizer(manageProjectsToken)),
+	))
+	account, credential, err := developer.CreateServiceAccount(ctx, projectID, "my-service")
+	if err != nil {
+		// Handle error
+	}
+	// Store credential.Secret; it cannot be retrieved again
+
+Inspecting an identity's consents when a service reports that consent is
+required:
+
+	forest, err := authClient.GetConsentForest(ctx, identityID, nil)
+	if err != nil {
+		// Handle error
+	}
+	if !forest.MeetsScopeRequirements(requiredScope) {
+		fmt.Print(forest)
+	}
 */
 package auth
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
 )
 
 // Common error codes returned by the Globus Auth API
@@ -49,21 +51,83 @@ var (
 	ErrBadRequest = errors.New("bad request")
 )
 
-// AuthError represents an error from the Globus Auth API
+// codeErrors maps Globus Auth error codes to the errors in this package that
+// they match with errors.Is
+var codeErrors = map[string]error{
+	ErrCodeInvalidGrant:           ErrInvalidGrant,
+	ErrCodeInvalidClient:          ErrInvalidClient,
+	ErrCodeInvalidScope:           ErrInvalidScope,
+	ErrCodeAccessDenied:           ErrAccessDenied,
+	ErrCodeServerError:            ErrServerError,
+	ErrCodeTemporarilyUnavailable: ErrServerError,
+}
+
+// AuthError represents an error from the Globus Auth API. It matches the
+// errors in this package for its code, such as ErrInvalidGrant, and the
+// shared core errors for its status and code, such as core.ErrForbidden.
 type AuthError struct {
 	Code        string `json:"error"`
 	Description string `json:"error_description,omitempty"`
+	RequestID   string `json:"request_id,omitempty"`
 	StatusCode  int    `json:"-"`
+	RawBody     []byte `json:"-"`
 }
 
 // Error returns a string representation of the error
 func (e *AuthError) Error() string {
+	code := e.Code
+	if code == "" {
+		code = fmt.Sprintf("request failed with status code %d", e.StatusCode)
+	}
 	if e.Description != "" {
-		return fmt.Sprintf("%s: %s", e.Code, e.Description)
+		return fmt.Sprintf("%s: %s", code, e.Description)
+	}
+	return code
+}
+
+// Is reports whether target is one of the errors in this package matching
+// the error's code or status, or a core error matching its status and code
+func (e *AuthError) Is(target error) bool {
+	if core.MatchesError(e.StatusCode, e.Code, target) {
+		return true
+	}
+	if err, ok := codeErrors[e.Code]; ok && target == err {
+		return true
+	}
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrTokenExpired:
+		// Globus Auth reports expired refresh tokens as invalid grants
+		description := strings.ToLower(e.Description)
+		return e.Code == ErrCodeInvalidGrant &&
+			strings.Contains(description, "refresh token") && strings.Contains(description, "expired")
 	}
+	return false
+}
+
+// HTTPStatus returns the HTTP status code of the response
+func (e *AuthError) HTTPStatus() int {
+	return e.StatusCode
+}
+
+// ErrorCode returns the Globus Auth error code
+func (e *AuthError) ErrorCode() string {
 	return e.Code
 }
 
+// ErrorRequestID returns the Globus Auth request ID
+func (e *AuthError) ErrorRequestID() string {
+	return e.RequestID
+}
+
+// ResponseBody returns the raw body of the response
+func (e *AuthError) ResponseBody() []byte {
+	return e.RawBody
+}
+
 // IsInvalidGrant checks if the error is an invalid grant error
 func IsInvalidGrant(err error) bool {
 	var authErr *AuthError
@@ -127,49 +191,31 @@ func IsBadRequest(err error) bool {
 	return errors.Is(err, ErrBadRequest)
 }
 
-// parseAuthError parses an error response from the Globus Auth API
+// parseAuthError parses an error response from the Globus Auth API into an
+// *AuthError
 func parseAuthError(statusCode int, respBody []byte) error {
-	// If the response body is empty, return a generic error based on status code
-	if len(respBody) == 0 {
-		switch statusCode {
-		case http.StatusUnauthorized:
-			return ErrUnauthorized
-		case http.StatusBadRequest:
-			return ErrBadRequest
-		default:
-			return fmt.Errorf("request failed with status code %d", statusCode)
-		}
-	}
-
-	// Try to parse the error as JSON
-	var authErr AuthError
-	if err := json.Unmarshal(respBody, &authErr); err != nil {
-		// If parsing fails, return the body as a string
-		return fmt.Errorf("request failed with status code %d: %s", statusCode, string(respBody))
-	}
-
-	// Set the status code for later checking
-	authErr.StatusCode = statusCode
-
-	// Map common error codes to standard errors or return the AuthError directly
-	switch authErr.Code {
-	case ErrCodeInvalidGrant:
-		// Check specifically for refresh token expiration
-		if strings.Contains(strings.ToLower(authErr.Description), "refresh token") &&
-			strings.Contains(strings.ToLower(authErr.Description), "expired") {
-			return fmt.Errorf("%w: %s", ErrTokenExpired, authErr.Description)
-		}
-		// Return the AuthError directly to maintain its type for other invalid_grant errors
-		return &authErr
-	case ErrCodeInvalidClient:
-		return fmt.Errorf("%w: %s", ErrInvalidClient, authErr.Description)
-	case ErrCodeInvalidScope:
-		return fmt.Errorf("%w: %s", ErrInvalidScope, authErr.Description)
-	case ErrCodeAccessDenied:
-		return fmt.Errorf("%w: %s", ErrAccessDenied, authErr.Description)
-	case ErrCodeServerError, ErrCodeTemporarilyUnavailable:
-		return fmt.Errorf("%w: %s", ErrServerError, authErr.Description)
-	default:
-		return &authErr
+	authErr := &AuthError{
+		StatusCode: statusCode,
+		RawBody:    respBody,
+	}
+
+	// Try to parse the error as JSON, keeping non-JSON bodies as the description
+	if len(respBody) > 0 && json.Unmarshal(respBody, authErr) != nil {
+		authErr.Description = string(respBody)
+	}
+	return authErr
+}
+
+// asAuthError converts an error response returned by the core client into an
+// *AuthError. Other errors are returned unchanged.
+func asAuthError(err error) error {
+	var coreErr *core.Error
+	if !errors.As(err, &coreErr) {
+		return err
+	}
+	authErr := parseAuthError(coreErr.StatusCode, coreErr.RawBody).(*AuthError)
+	if authErr.RequestID == "" {
+		authErr.RequestID = coreErr.RequestID
 	}
+	return authErr
 }
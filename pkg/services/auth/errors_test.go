@@ -3,9 +3,13 @@
 package auth
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
 )
 
 func TestAuthError(t *testing.T) {
@@ -180,3 +184,37 @@ func TestParseAuthError(t *testing.T) {
 		t.Errorf("parseAuthError() returned wrong code, got %q, want %q", authErr.Code, "unknown_error")
 	}
 }
+
+func TestAuthErrorTaxonomy(t *testing.T) {
+	server, client := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-1")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": "invalid_grant", "error_description": "The refresh token has expired"}`))
+	})
+	defer server.Close()
+
+	_, err := client.RefreshToken(context.Background(), "expired-token")
+	for _, target := range []error{ErrInvalidGrant, ErrTokenExpired, ErrBadRequest, core.ErrBadRequest} {
+		if !errors.Is(err, target) {
+			t.Errorf("errors.Is(%v, %v) = false", err, target)
+		}
+	}
+	if errors.Is(err, ErrInvalidClient) || errors.Is(err, core.ErrAuthorizationRequired) {
+		t.Errorf("errors.Is(%v) matched an unrelated error", err)
+	}
+
+	var authErr *AuthError
+	if !errors.As(err, &authErr) || authErr.RequestID != "req-1" || len(authErr.RawBody) == 0 {
+		t.Fatalf("errors.As(%v, *AuthError) = %+v", err, authErr)
+	}
+	if !IsInvalidGrant(err) {
+		t.Errorf("IsInvalidGrant(%v) = false", err)
+	}
+
+	paramsErr := ParseAuthorizationParameters(http.StatusForbidden,
+		[]byte(`{"code": "ConsentRequired", "message": "Missing consent", "required_scopes": ["scope-a"]}`))
+	if !errors.Is(paramsErr, core.ErrAuthorizationRequired) || !errors.Is(paramsErr, core.ErrConsentRequired) {
+		t.Errorf("ParseAuthorizationParameters() = %v, want a consent error", paramsErr)
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SessionInfo describes the user's current Globus Auth session
+type SessionInfo struct {
+	SessionID string `json:"session_id"`
+
+	// Authentications maps the ID of each identity the user has logged in
+	// with during the session to the details of that login
+	Authentications map[string]SessionAuthentication `json:"authentications"`
+}
+
+// SessionAuthentication is one login within a session
+type SessionAuthentication struct {
+	AuthTime         int64    `json:"auth_time"`
+	IdentityProvider string   `json:"idp"`
+	ACR              string   `json:"acr,omitempty"`
+	AMR              []string `json:"amr,omitempty"`
+}
+
+// Time returns the time of the login
+func (a SessionAuthentication) Time() time.Time {
+	return time.Unix(a.AuthTime, 0)
+}
+
+// GetIdentitiesOptions selects the identities returned by GetIdentities.
+// Either IDs or Usernames must be set.
+type GetIdentitiesOptions struct {
+	IDs       []string
+	Usernames []string
+
+	// Provision creates identities for usernames that Globus Auth has not
+	// seen yet, instead of omitting them
+	Provision bool
+}
+
+// GetIdentities looks up identities by ID or username. The client's
+// authorizer must hold a Globus Auth token, such as one for the openid or
+// view_identities scopes.
+func (c *Client) GetIdentities(ctx context.Context, options *GetIdentitiesOptions) (*IdentitySet, error) {
+	if options == nil || (len(options.IDs) == 0 && len(options.Usernames) == 0) {
+		return nil, fmt.Errorf("identity IDs or usernames are required")
+	}
+	if len(options.IDs) > 0 && len(options.Usernames) > 0 {
+		return nil, fmt.Errorf("identity IDs and usernames cannot be combined")
+	}
+
+	query := url.Values{}
+	if len(options.IDs) > 0 {
+		query.Set("ids", strings.Join(options.IDs, ","))
+	} else {
+		query.Set("usernames", strings.Join(options.Usernames, ","))
+	}
+	if options.Provision {
+		query.Set("provision", "true")
+	}
+
+	var identities IdentitySet
+	if err := c.doAPIRequest(ctx, http.MethodGet, "api/identities", query, nil, &identities); err != nil {
+		return nil, err
+	}
+	return &identities, nil
+}
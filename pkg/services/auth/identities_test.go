@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetIdentities(t *testing.T) {
+	server, client := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/identities" || r.URL.Query().Get("usernames") != "a@example.org,b@example.org" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"identities": [
+			{"id": "id-a", "username": "a@example.org", "status": "used", "identity_provider": "idp-1"},
+			{"id": "id-b", "username": "b@example.org", "status": "unused"}]}`))
+	})
+	defer server.Close()
+	ctx := context.Background()
+
+	identities, err := client.GetIdentities(ctx, &GetIdentitiesOptions{Usernames: []string{"a@example.org", "b@example.org"}})
+	if err != nil {
+		t.Fatalf("GetIdentities() error = %v", err)
+	}
+	if len(identities.Identities) != 2 || identities.Identities[0].IdentityID != "id-a" || identities.Identities[1].Status != "unused" {
+		t.Errorf("GetIdentities() = %+v", identities)
+	}
+
+	if _, err := client.GetIdentities(ctx, &GetIdentitiesOptions{}); err == nil {
+		t.Error("GetIdentities() accepted empty options")
+	}
+}
+
+func TestIntrospectTokenWithSessionInfo(t *testing.T) {
+	server, client := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("include") != "session_info,identity_set" {
+			t.Errorf("include = %q", r.Form.Get("include"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"active": true, "sub": "id-a", "identity_set": ["id-a", "id-b"],
+			"session_info": {"session_id": "s-1", "authentications": {
+				"id-a": {"auth_time": 1735689600, "idp": "idp-1", "amr": ["mfa"]}}}}`))
+	})
+	defer server.Close()
+
+	info, err := client.IntrospectTokenWithSessionInfo(context.Background(), "token")
+	if err != nil {
+		t.Fatalf("IntrospectTokenWithSessionInfo() error = %v", err)
+	}
+	if info.SessionInfo == nil || info.SessionInfo.SessionID != "s-1" {
+		t.Fatalf("SessionInfo = %+v", info.SessionInfo)
+	}
+	login, ok := info.SessionInfo.Authentications["id-a"]
+	if !ok || login.IdentityProvider != "idp-1" || login.Time().Year() != 2025 || login.AMR[0] != "mfa" {
+		t.Errorf("Authentications = %+v", info.SessionInfo.Authentications)
+	}
+}
+
+func TestGetUserInfoIdentitySet(t *testing.T) {
+	server, client := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/oauth2/userinfo" || r.Header.Get("Authorization") != "Bearer user-token" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"sub": "id-a", "preferred_username": "a@example.org", "last_authentication": 1700000000,
+			"identity_set": [
+				{"sub": "id-a", "username": "a@example.org", "identity_provider": "idp-1", "last_authentication": 1700000000},
+				{"sub": "id-b", "username": "b@example.org", "identity_provider": "idp-2", "last_authentication": null}]}`))
+	})
+	defer server.Close()
+
+	info, err := client.GetUserInfo(context.Background(), "user-token")
+	if err != nil {
+		t.Fatalf("GetUserInfo() error = %v", err)
+	}
+	if info.Sub != "id-a" || info.LastAuthenticated.Unix() != 1700000000 {
+		t.Errorf("GetUserInfo() = %+v", info)
+	}
+	if len(info.IdentitySet) != 2 || info.IdentitySet[0].LastAuthentication != 1700000000 || info.IdentitySet[1].LastAuthentication != 0 {
+		t.Errorf("IdentitySet = %+v", info.IdentitySet)
+	}
+}
@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultIDTokenLeeway is the clock skew tolerated when checking the time
+// claims of an ID token
+const DefaultIDTokenLeeway = time.Minute
+
+// jwksCacheTTL is how long a fetched key set is used before it is fetched again
+const jwksCacheTTL = time.Hour
+
+// jwksMinRefreshInterval limits how often an unknown key ID causes the key
+// set to be fetched again. It is a variable so tests can shorten it.
+var jwksMinRefreshInterval = time.Minute
+
+// ErrInvalidIDToken is returned, wrapped with the reason, when an ID token
+// fails verification
+var ErrInvalidIDToken = errors.New("invalid ID token")
+
+// IdentitySetEntry is one of the identities linked to the authenticated user,
+// as listed in the identity_set claim
+type IdentitySetEntry struct {
+	Subject                     string `json:"sub"`
+	Name                        string `json:"name,omitempty"`
+	Email                       string `json:"email,omitempty"`
+	Username                    string `json:"username"`
+	Organization                string `json:"organization,omitempty"`
+	IdentityProvider            string `json:"identity_provider"`
+	IdentityProviderDisplayName string `json:"identity_provider_display_name,omitempty"`
+	LastAuthentication          int64  `json:"last_authentication,omitempty"`
+}
+
+// IDTokenClaims are the claims of a verified Globus Auth ID token
+type IDTokenClaims struct {
+	Issuer                      string             `json:"iss"`
+	Subject                     string             `json:"sub"`
+	Audience                    []string           `json:"-"`
+	AuthorizedParty             string             `json:"azp,omitempty"`
+	ExpiresAt                   time.Time          `json:"-"`
+	IssuedAt                    time.Time          `json:"-"`
+	Nonce                       string             `json:"nonce,omitempty"`
+	PreferredUsername           string             `json:"preferred_username,omitempty"`
+	Name                        string             `json:"name,omitempty"`
+	Email                       string             `json:"email,omitempty"`
+	Organization                string             `json:"organization,omitempty"`
+	IdentityProvider            string             `json:"identity_provider,omitempty"`
+	IdentityProviderDisplayName string             `json:"identity_provider_display_name,omitempty"`
+	IdentitySet                 []IdentitySetEntry `json:"identity_set,omitempty"`
+
+	// Raw holds every claim, including those without a field
+	Raw map[string]interface{} `json:"-"`
+}
+
+// UnmarshalJSON decodes the claims, accepting a single string or a list for
+// aud and converting the numeric time claims
+func (c *IDTokenClaims) UnmarshalJSON(data []byte) error {
+	type plain IDTokenClaims
+	var times struct {
+		Audience  json.RawMessage `json:"aud"`
+		ExpiresAt json.Number     `json:"exp"`
+		IssuedAt  json.Number     `json:"iat"`
+	}
+	if err := json.Unmarshal(data, (*plain)(c)); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &times); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &c.Raw); err != nil {
+		return err
+	}
+
+	if len(times.Audience) > 0 {
+		var single string
+		if err := json.Unmarshal(times.Audience, &single); err == nil {
+			c.Audience = []string{single}
+		} else if err := json.Unmarshal(times.Audience, &c.Audience); err != nil {
+			return fmt.Errorf("invalid aud claim: %w", err)
+		}
+	}
+
+	var err error
+	if c.ExpiresAt, err = numericDate(times.ExpiresAt); err != nil {
+		return fmt.Errorf("invalid exp claim: %w", err)
+	}
+	if c.IssuedAt, err = numericDate(times.IssuedAt); err != nil {
+		return fmt.Errorf("invalid iat claim: %w", err)
+	}
+	return nil
+}
+
+// numericDate converts a JWT NumericDate, which may have a fraction
+func numericDate(value json.Number) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	seconds, err := value.Float64()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, int64(seconds*float64(time.Second))), nil
+}
+
+// IDTokenOption configures ID token verification
+type IDTokenOption func(*idTokenOptions)
+
+// idTokenOptions holds the settings applied by IDTokenOptions
+type idTokenOptions struct {
+	nonce  string
+	leeway time.Duration
+	now    func() time.Time
+}
+
+// WithNonce requires the token's nonce claim to equal nonce, the value sent
+// in the authorization request
+func WithNonce(nonce string) IDTokenOption {
+	return func(o *idTokenOptions) {
+		o.nonce = nonce
+	}
+}
+
+// WithLeeway sets the clock skew tolerated when checking exp and iat
+func WithLeeway(leeway time.Duration) IDTokenOption {
+	return func(o *idTokenOptions) {
+		o.leeway = leeway
+	}
+}
+
+// VerifyIDToken verifies an ID token issued to this client and returns its
+// claims. The RS256 signature is checked against the Globus Auth key set,
+// which is fetched on first use and cached. The token must be issued by the
+// Auth service at the client's base URL, list the client ID as an audience,
+// be unexpired and not issued in the future. Use WithNonce to check the
+// nonce as well.
+func (c *Client) VerifyIDToken(ctx context.Context, idToken string, opts ...IDTokenOption) (*IDTokenClaims, error) {
+	options := &idTokenOptions{leeway: DefaultIDTokenLeeway, now: time.Now}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidIDToken)
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+		KeyID     string `json:"kid"`
+	}
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: malformed header: %v", ErrInvalidIDToken, err)
+	}
+	if header.Algorithm != "RS256" {
+		return nil, fmt.Errorf("%w: unsupported signing algorithm %q", ErrInvalidIDToken, header.Algorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidIDToken)
+	}
+	key, err := c.signingKey(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("%w: signature verification failed", ErrInvalidIDToken)
+	}
+
+	var claims IDTokenClaims
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: malformed claims: %v", ErrInvalidIDToken, err)
+	}
+	if err := c.checkIDTokenClaims(&claims, options); err != nil {
+		return nil, err
+	}
+	return &claims, nil
+}
+
+// checkIDTokenClaims validates the registered claims of a verified token
+func (c *Client) checkIDTokenClaims(claims *IDTokenClaims, options *idTokenOptions) error {
+	if issuer := c.issuer(); claims.Issuer != issuer {
+		return fmt.Errorf("%w: issuer %q, want %q", ErrInvalidIDToken, claims.Issuer, issuer)
+	}
+
+	audienceOK := false
+	for _, audience := range claims.Audience {
+		if audience == c.ClientID {
+			audienceOK = true
+			break
+		}
+	}
+	if !audienceOK {
+		return fmt.Errorf("%w: token was not issued to client %s", ErrInvalidIDToken, c.ClientID)
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != "" && claims.AuthorizedParty != c.ClientID {
+		return fmt.Errorf("%w: authorized party %q, want %q", ErrInvalidIDToken, claims.AuthorizedParty, c.ClientID)
+	}
+
+	now := options.now()
+	if claims.ExpiresAt.IsZero() || !now.Before(claims.ExpiresAt.Add(options.leeway)) {
+		return fmt.Errorf("%w: token expired at %s", ErrInvalidIDToken, claims.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+	if claims.IssuedAt.IsZero() || claims.IssuedAt.After(now.Add(options.leeway)) {
+		return fmt.Errorf("%w: token issued in the future", ErrInvalidIDToken)
+	}
+
+	if options.nonce != "" && claims.Nonce != options.nonce {
+		return fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+	return nil
+}
+
+// issuer returns the expected iss claim: the Auth service root, which is
+// the base URL without its API version
+func (c *Client) issuer() string {
+	return strings.TrimSuffix(strings.TrimSuffix(c.Client.BaseURL, "/"), "/v2")
+}
+
+// decodeJWTSegment decodes one base64url segment of a JWT into v
+func decodeJWTSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// jwksCache holds the Auth service signing keys by key ID
+type jwksCache struct {
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+// signingKey returns the public key with the given ID, fetching the key set
+// when the cache is empty or stale, or when the key is unknown and the set
+// has not been fetched recently (keys may have been rotated)
+func (c *Client) signingKey(ctx context.Context, keyID string) (*rsa.PublicKey, error) {
+	c.jwks.mu.Lock()
+	defer c.jwks.mu.Unlock()
+
+	age := time.Since(c.jwks.fetchedAt)
+	key, ok := c.jwks.keys[keyID]
+	if c.jwks.keys == nil || age > jwksCacheTTL || (!ok && age > jwksMinRefreshInterval) {
+		keys, err := c.fetchJWKS(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.jwks.keys = keys
+		c.jwks.fetchedAt = time.Now()
+		key, ok = keys[keyID]
+	}
+
+	if !ok {
+		// Tokens without a key ID can only be verified against a single key
+		if keyID == "" && len(c.jwks.keys) == 1 {
+			for _, only := range c.jwks.keys {
+				return only, nil
+			}
+		}
+		return nil, fmt.Errorf("%w: unknown signing key %q", ErrInvalidIDToken, keyID)
+	}
+	return key, nil
+}
+
+// fetchJWKS downloads the Auth service key set and returns its RSA keys
+func (c *Client) fetchJWKS(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.issuer()+"/jwk.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+
+	resp, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("JWKS request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("JWKS request failed with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var set struct {
+		Keys []struct {
+			KeyType string `json:"kty"`
+			KeyID   string `json:"kid"`
+			Use     string `json:"use"`
+			N       string `json:"n"`
+			E       string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS response: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.KeyType != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(jwk.N)
+		e, errE := base64.RawURLEncoding.DecodeString(jwk.E)
+		if errN != nil || errE != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("invalid RSA key %q in JWKS", jwk.KeyID)
+		}
+		keys[jwk.KeyID] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return keys, nil
+}
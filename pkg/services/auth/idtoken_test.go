@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testKeySet serves a JSON Web Key Set for locally generated keys
+type testKeySet struct {
+	keys    map[string]*rsa.PrivateKey
+	fetches int32
+}
+
+func newTestKeySet(t *testing.T, keyIDs ...string) *testKeySet {
+	t.Helper()
+	set := &testKeySet{keys: make(map[string]*rsa.PrivateKey)}
+	for _, id := range keyIDs {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("GenerateKey() error = %v", err)
+		}
+		set.keys[id] = key
+	}
+	return set
+}
+
+func (s *testKeySet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/jwk.json" {
+		http.NotFound(w, r)
+		return
+	}
+	atomic.AddInt32(&s.fetches, 1)
+
+	var keys []map[string]string
+	for id, key := range s.keys {
+		keys = append(keys, map[string]string{
+			"kty": "RSA",
+			"kid": id,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+}
+
+// sign returns a JWT for claims signed with the key keyID
+func (s *testKeySet) sign(t *testing.T, keyID string, claims map[string]interface{}) string {
+	t.Helper()
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": keyID})
+	payload, _ := json.Marshal(claims)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.keys[keyID], crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("SignPKCS1v15() error = %v", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// validClaims returns the claims of a token issued by server to the test client
+func validClaims(server *httptest.Server) map[string]interface{} {
+	now := time.Now()
+	return map[string]interface{}{
+		"iss":                server.URL,
+		"sub":                "ae341a98-d274-11e5-b888-dbae3a8ba545",
+		"aud":                "test-client-id",
+		"exp":                now.Add(time.Hour).Unix(),
+		"iat":                now.Unix(),
+		"nonce":              "n-0S6_WzA2Mj",
+		"preferred_username": "user@example.org",
+		"identity_set": []map[string]interface{}{
+			{"sub": "ae341a98-d274-11e5-b888-dbae3a8ba545", "username": "user@example.org", "identity_provider": "41143743-f3c8-4d60-bbdb-eeecaba85bd9"},
+			{"sub": "c8aad43e-d274-11e5-bf98-8b02896cf782", "username": "user@globusid.org", "identity_provider": "611ba1b8-8d40-4c4d-b6e1-1e2b7f3a1b25"},
+		},
+	}
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	keys := newTestKeySet(t, "key-1")
+	server, client := setupMockServer(keys.ServeHTTP)
+	defer server.Close()
+
+	token := keys.sign(t, "key-1", validClaims(server))
+	claims, err := client.VerifyIDToken(context.Background(), token, WithNonce("n-0S6_WzA2Mj"))
+	if err != nil {
+		t.Fatalf("VerifyIDToken() error = %v", err)
+	}
+	if claims.Subject != "ae341a98-d274-11e5-b888-dbae3a8ba545" || claims.PreferredUsername != "user@example.org" {
+		t.Errorf("VerifyIDToken() claims = %+v", claims)
+	}
+	if len(claims.IdentitySet) != 2 || claims.IdentitySet[1].Username != "user@globusid.org" {
+		t.Errorf("IdentitySet = %+v", claims.IdentitySet)
+	}
+	if len(claims.Audience) != 1 || claims.ExpiresAt.Before(time.Now()) || claims.Raw["nonce"] != "n-0S6_WzA2Mj" {
+		t.Errorf("VerifyIDToken() registered claims = %+v", claims)
+	}
+
+	// The key set is cached
+	if _, err := client.VerifyIDToken(context.Background(), token); err != nil {
+		t.Fatalf("VerifyIDToken() second call error = %v", err)
+	}
+	if fetches := atomic.LoadInt32(&keys.fetches); fetches != 1 {
+		t.Errorf("JWKS fetched %d times, want 1", fetches)
+	}
+}
+
+func TestVerifyIDTokenRejects(t *testing.T) {
+	keys := newTestKeySet(t, "key-1")
+	server, client := setupMockServer(keys.ServeHTTP)
+	defer server.Close()
+	other := newTestKeySet(t, "key-1")
+
+	tests := []struct {
+		name   string
+		token  func() string
+		option IDTokenOption
+	}{
+		{
+			name: "wrong issuer",
+			token: func() string {
+				c := validClaims(server)
+				c["iss"] = "https://evil.example.org"
+				return keys.sign(t, "key-1", c)
+			},
+		},
+		{
+			name: "wrong audience",
+			token: func() string {
+				c := validClaims(server)
+				c["aud"] = []string{"other-client"}
+				return keys.sign(t, "key-1", c)
+			},
+		},
+		{
+			name: "expired",
+			token: func() string {
+				c := validClaims(server)
+				c["exp"] = time.Now().Add(-2 * DefaultIDTokenLeeway).Unix()
+				return keys.sign(t, "key-1", c)
+			},
+		},
+		{
+			name: "issued in the future",
+			token: func() string {
+				c := validClaims(server)
+				c["iat"] = time.Now().Add(2 * DefaultIDTokenLeeway).Unix()
+				return keys.sign(t, "key-1", c)
+			},
+		},
+		{
+			name:   "nonce mismatch",
+			token:  func() string { return keys.sign(t, "key-1", validClaims(server)) },
+			option: WithNonce("another-nonce"),
+		},
+		{
+			name:  "signed by another key",
+			token: func() string { return other.sign(t, "key-1", validClaims(server)) },
+		},
+		{
+			name: "tampered claims",
+			token: func() string {
+				parts := strings.Split(keys.sign(t, "key-1", validClaims(server)), ".")
+				c := validClaims(server)
+				c["sub"] = "someone-else"
+				payload, _ := json.Marshal(c)
+				return parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload) + "." + parts[2]
+			},
+		},
+		{
+			name: "unsigned",
+			token: func() string {
+				header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+				payload, _ := json.Marshal(validClaims(server))
+				return header + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+			},
+		},
+		{
+			name:  "malformed",
+			token: func() string { return "not-a-jwt" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []IDTokenOption
+			if tt.option != nil {
+				opts = append(opts, tt.option)
+			}
+			_, err := client.VerifyIDToken(context.Background(), tt.token(), opts...)
+			if !errors.Is(err, ErrInvalidIDToken) {
+				t.Errorf("VerifyIDToken() error = %v, want ErrInvalidIDToken", err)
+			}
+		})
+	}
+}
+
+func TestVerifyIDTokenKeyRotation(t *testing.T) {
+	defer func(interval time.Duration) { jwksMinRefreshInterval = interval }(jwksMinRefreshInterval)
+	jwksMinRefreshInterval = 0
+
+	keys := newTestKeySet(t, "key-1")
+	server, client := setupMockServer(keys.ServeHTTP)
+	defer server.Close()
+
+	if _, err := client.VerifyIDToken(context.Background(), keys.sign(t, "key-1", validClaims(server))); err != nil {
+		t.Fatalf("VerifyIDToken() error = %v", err)
+	}
+
+	// Auth starts signing with a new key; the key set is fetched again
+	rotated := newTestKeySet(t, "key-2")
+	keys.keys["key-2"] = rotated.keys["key-2"]
+	if _, err := client.VerifyIDToken(context.Background(), keys.sign(t, "key-2", validClaims(server))); err != nil {
+		t.Fatalf("VerifyIDToken() with rotated key error = %v", err)
+	}
+	if fetches := atomic.LoadInt32(&keys.fetches); fetches != 2 {
+		t.Errorf("JWKS fetched %d times, want 2", fetches)
+	}
+}
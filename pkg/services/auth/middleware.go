@@ -0,0 +1,285 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultNegativeCacheTTL is how long an inactive token is remembered by
+// IntrospectionMiddleware when no TTL is configured
+const DefaultNegativeCacheTTL = 5 * time.Minute
+
+// introspectionSweepInterval is how often expired cache entries are removed
+const introspectionSweepInterval = time.Minute
+
+// Bearer token error codes defined by RFC 6750
+const (
+	BearerErrorInvalidRequest    = "invalid_request"
+	BearerErrorInvalidToken      = "invalid_token"
+	BearerErrorInsufficientScope = "insufficient_scope"
+)
+
+// tokenInfoContextKey is the context key for the introspected TokenInfo
+type tokenInfoContextKey struct{}
+
+// TokenInfoFromContext returns the TokenInfo stored by IntrospectionMiddleware
+func TokenInfoFromContext(ctx context.Context) (*TokenInfo, bool) {
+	info, ok := ctx.Value(tokenInfoContextKey{}).(*TokenInfo)
+	return info, ok
+}
+
+// IdentitySetFromContext returns the IDs of the identities linked to the
+// owner of the request's token, as stored by IntrospectionMiddleware
+func IdentitySetFromContext(ctx context.Context) []string {
+	if info, ok := TokenInfoFromContext(ctx); ok {
+		return info.IdentitySet
+	}
+	return nil
+}
+
+// IntrospectionOption configures an IntrospectionMiddleware
+type IntrospectionOption func(*IntrospectionMiddleware)
+
+// RequireScopes rejects tokens that lack any of scopes
+func RequireScopes(scopes ...string) IntrospectionOption {
+	return func(m *IntrospectionMiddleware) {
+		m.requiredScopes = append(m.requiredScopes, scopes...)
+	}
+}
+
+// RequireAudiences rejects tokens not issued for at least one of audiences,
+// usually the resource server's client ID
+func RequireAudiences(audiences ...string) IntrospectionOption {
+	return func(m *IntrospectionMiddleware) {
+		m.audiences = append(m.audiences, audiences...)
+	}
+}
+
+// WithNegativeCacheTTL sets how long inactive tokens are remembered
+func WithNegativeCacheTTL(ttl time.Duration) IntrospectionOption {
+	return func(m *IntrospectionMiddleware) {
+		m.negativeTTL = ttl
+	}
+}
+
+// WithMaxCacheTTL limits how long an active token is trusted without being
+// introspected again, so revoked tokens are noticed sooner. By default
+// active tokens are cached until they expire.
+func WithMaxCacheTTL(ttl time.Duration) IntrospectionOption {
+	return func(m *IntrospectionMiddleware) {
+		m.maxTTL = ttl
+	}
+}
+
+// WithRealm sets the realm reported in WWW-Authenticate headers
+func WithRealm(realm string) IntrospectionOption {
+	return func(m *IntrospectionMiddleware) {
+		m.realm = realm
+	}
+}
+
+// IntrospectionMiddleware authenticates requests to a resource server by
+// introspecting their bearer tokens with Globus Auth. Results are cached by
+// a hash of the token: active tokens until they expire and inactive tokens
+// for the negative cache TTL. Failures are reported with RFC 6750
+// WWW-Authenticate headers.
+type IntrospectionMiddleware struct {
+	client         *Client
+	requiredScopes []string
+	audiences      []string
+	negativeTTL    time.Duration
+	maxTTL         time.Duration
+	realm          string
+	now            func() time.Time
+
+	mu        sync.Mutex
+	cache     map[[sha256.Size]byte]introspectionResult
+	lastSweep time.Time
+}
+
+// introspectionResult is a cached introspection response; info is nil for
+// inactive tokens
+type introspectionResult struct {
+	info    *TokenInfo
+	expires time.Time
+}
+
+// NewIntrospectionMiddleware creates middleware that introspects tokens with
+// client, which must be configured with the resource server's client ID and
+// secret
+func NewIntrospectionMiddleware(client *Client, opts ...IntrospectionOption) *IntrospectionMiddleware {
+	m := &IntrospectionMiddleware{
+		client:      client,
+		negativeTTL: DefaultNegativeCacheTTL,
+		now:         time.Now,
+		cache:       make(map[[sha256.Size]byte]introspectionResult),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Handler wraps next so that it is only called for requests with an active
+// token that satisfies the required scopes and audiences. The token's
+// TokenInfo is available to next through TokenInfoFromContext.
+func (m *IntrospectionMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, isBearer := bearerToken(r)
+		if !isBearer {
+			// A missing header and other schemes get a challenge without an
+			// error code (RFC 6750 section 3.1)
+			m.writeError(w, http.StatusUnauthorized, "", "", "")
+			return
+		}
+		if token == "" {
+			m.writeError(w, http.StatusBadRequest, BearerErrorInvalidRequest, "malformed Authorization header", "")
+			return
+		}
+
+		info, err := m.Introspect(r.Context(), token)
+		if err != nil {
+			http.Error(w, "token introspection failed", http.StatusServiceUnavailable)
+			return
+		}
+		if info == nil {
+			m.writeError(w, http.StatusUnauthorized, BearerErrorInvalidToken, "the access token is not active", "")
+			return
+		}
+		if !m.audienceAllowed(info) {
+			m.writeError(w, http.StatusUnauthorized, BearerErrorInvalidToken, "the access token was not issued for this resource server", "")
+			return
+		}
+		if missing := missingScopes(info.Scope, m.requiredScopes); len(missing) > 0 {
+			m.writeError(w, http.StatusForbidden, BearerErrorInsufficientScope,
+				"the access token lacks required scopes", strings.Join(m.requiredScopes, " "))
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), tokenInfoContextKey{}, info)))
+	})
+}
+
+// Introspect returns the TokenInfo of an active token, or nil if the token is
+// not active, using cached results when available
+func (m *IntrospectionMiddleware) Introspect(ctx context.Context, token string) (*TokenInfo, error) {
+	key := sha256.Sum256([]byte(token))
+	now := m.now()
+
+	m.mu.Lock()
+	result, ok := m.cache[key]
+	m.mu.Unlock()
+	if ok && now.Before(result.expires) {
+		return result.info, nil
+	}
+
+	info, err := m.client.IntrospectTokenWithIdentitySet(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	result = introspectionResult{expires: now.Add(m.negativeTTL)}
+	if info.Active && time.Unix(info.Exp, 0).After(now) {
+		result.info = info
+		result.expires = time.Unix(info.Exp, 0)
+		if m.maxTTL > 0 && result.expires.After(now.Add(m.maxTTL)) {
+			result.expires = now.Add(m.maxTTL)
+		}
+	}
+
+	m.mu.Lock()
+	m.cache[key] = result
+	if now.Sub(m.lastSweep) > introspectionSweepInterval {
+		for k, cached := range m.cache {
+			if !now.Before(cached.expires) {
+				delete(m.cache, k)
+			}
+		}
+		m.lastSweep = now
+	}
+	m.mu.Unlock()
+
+	return result.info, nil
+}
+
+// audienceAllowed reports whether the token was issued for one of the
+// required audiences
+func (m *IntrospectionMiddleware) audienceAllowed(info *TokenInfo) bool {
+	if len(m.audiences) == 0 {
+		return true
+	}
+	for _, audience := range info.Audience {
+		if containsString(m.audiences, audience) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeError writes an RFC 6750 error response. With no error code only the
+// challenge is sent, as for requests without credentials.
+func (m *IntrospectionMiddleware) writeError(w http.ResponseWriter, status int, code, description, scope string) {
+	var params []string
+	if m.realm != "" {
+		params = append(params, fmt.Sprintf("realm=%q", m.realm))
+	}
+	if code != "" {
+		params = append(params, fmt.Sprintf("error=%q", code))
+	}
+	if description != "" {
+		params = append(params, fmt.Sprintf("error_description=%q", description))
+	}
+	if scope != "" {
+		params = append(params, fmt.Sprintf("scope=%q", scope))
+	}
+
+	challenge := "Bearer"
+	if len(params) > 0 {
+		challenge += " " + strings.Join(params, ", ")
+	}
+	w.Header().Set("WWW-Authenticate", challenge)
+
+	if code == "" {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{Error: code, ErrorDescription: description})
+}
+
+// bearerToken extracts the token from a "Bearer" Authorization header.
+// isBearer reports whether the header uses the Bearer scheme; the token is
+// empty if a Bearer header is malformed.
+func bearerToken(r *http.Request) (token string, isBearer bool) {
+	scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	if strings.ContainsAny(token, " \t") {
+		return "", true
+	}
+	return token, true
+}
+
+// missingScopes returns the required scopes not in the space-separated
+// granted scope string
+func missingScopes(granted string, required []string) []string {
+	have := strings.Fields(granted)
+	var missing []string
+	for _, scope := range required {
+		if !containsString(have, scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
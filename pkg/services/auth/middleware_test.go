@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// introspectionServer answers introspection requests for a fixed set of tokens
+func introspectionServer(t *testing.T, calls *int32, exp int64) (*httptest.Server, *Client) {
+	t.Helper()
+	return setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		r.ParseForm()
+		if r.Form.Get("include") != "identity_set" {
+			t.Errorf("include = %q, want identity_set", r.Form.Get("include"))
+		}
+
+		info := TokenInfo{Active: true, Exp: exp, Subject: "user-1", IdentitySet: []string{"user-1", "user-2"},
+			Scope: "urn:example:read urn:example:write", Audience: []string{"resource-server"}}
+		switch r.Form.Get("token") {
+		case "good":
+		case "other-audience":
+			info.Audience = []string{"someone-else"}
+		case "read-only":
+			info.Scope = "urn:example:read"
+		default:
+			info = TokenInfo{Active: false}
+		}
+		json.NewEncoder(w).Encode(info)
+	})
+}
+
+func TestIntrospectionMiddleware(t *testing.T) {
+	var calls int32
+	server, client := introspectionServer(t, &calls, time.Now().Add(time.Hour).Unix())
+	defer server.Close()
+
+	middleware := NewIntrospectionMiddleware(client,
+		RequireScopes("urn:example:write"),
+		RequireAudiences("resource-server"),
+		WithRealm("example"),
+	)
+	handler := middleware.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info, ok := TokenInfoFromContext(r.Context())
+		if !ok || info.Subject != "user-1" || len(IdentitySetFromContext(r.Context())) != 2 {
+			t.Errorf("Context token info = %+v, %v", info, ok)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+		wantChallenge string
+	}{
+		{"valid", "Bearer good", http.StatusNoContent, ""},
+		{"lower-case scheme", "bearer good", http.StatusNoContent, ""},
+		{"missing", "", http.StatusUnauthorized, `Bearer realm="example"`},
+		{"other scheme", "Basic dXNlcjpwYXNz", http.StatusUnauthorized, `Bearer realm="example"`},
+		{"empty bearer", "Bearer", http.StatusBadRequest, `error="invalid_request"`},
+		{"malformed bearer", "Bearer two tokens", http.StatusBadRequest, `error="invalid_request"`},
+		{"inactive", "Bearer revoked", http.StatusUnauthorized, `error="invalid_token"`},
+		{"wrong audience", "Bearer other-audience", http.StatusUnauthorized, `error="invalid_token"`},
+		{"insufficient scope", "Bearer read-only", http.StatusForbidden, `error="insufficient_scope", error_description="the access token lacks required scopes", scope="urn:example:write"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/resource", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			challenge := rec.Header().Get("WWW-Authenticate")
+			if !strings.Contains(challenge, tt.wantChallenge) ||
+				(tt.wantStatus != http.StatusNoContent && !strings.HasPrefix(challenge, "Bearer")) {
+				t.Errorf("WWW-Authenticate = %q, want %q", challenge, tt.wantChallenge)
+			}
+			if !strings.Contains(tt.wantChallenge, "error=") && strings.Contains(challenge, "error=") {
+				t.Errorf("WWW-Authenticate = %q, want no error code", challenge)
+			}
+		})
+	}
+
+	// good, other-audience, read-only and revoked were each introspected once
+	if got := atomic.LoadInt32(&calls); got != 4 {
+		t.Errorf("Introspection calls = %d, want 4", got)
+	}
+}
+
+func TestIntrospectionMiddlewareCacheExpiry(t *testing.T) {
+	var calls int32
+	now := time.Now()
+	server, client := introspectionServer(t, &calls, now.Add(10*time.Minute).Unix())
+	defer server.Close()
+
+	middleware := NewIntrospectionMiddleware(client, WithNegativeCacheTTL(time.Minute))
+	middleware.now = func() time.Time { return now }
+
+	ctx := httptest.NewRequest(http.MethodGet, "/", nil).Context()
+	steps := []struct {
+		advance    time.Duration
+		token      string
+		wantActive bool
+		wantCalls  int32
+	}{
+		{0, "good", true, 1},
+		{5 * time.Minute, "good", true, 1},
+		{0, "revoked", false, 2},
+		{30 * time.Second, "revoked", false, 2},
+		{time.Minute, "revoked", false, 3},
+		// The active token has expired by now
+		{5 * time.Minute, "good", false, 4},
+	}
+	for i, step := range steps {
+		now = now.Add(step.advance)
+		info, err := middleware.Introspect(ctx, step.token)
+		if err != nil {
+			t.Fatalf("step %d: Introspect() error = %v", i, err)
+		}
+		if (info != nil) != step.wantActive {
+			t.Errorf("step %d: Introspect() = %+v, want active %v", i, info, step.wantActive)
+		}
+		if got := atomic.LoadInt32(&calls); got != step.wantCalls {
+			t.Errorf("step %d: introspection calls = %d, want %d", i, got, step.wantCalls)
+		}
+	}
+}
@@ -18,7 +18,8 @@ type TokenResponse struct {
 	DependentTokens []json.RawMessage `json:"dependent_tokens,omitempty"` // Raw to avoid recursion
 	Scope           string            `json:"scope"`
 	State           string            `json:"state,omitempty"`
-	ExpiryTime      time.Time         `json:"-"` // Calculated expiry time
+	IDToken         string            `json:"id_token,omitempty"` // Verify with Client.VerifyIDToken
+	ExpiryTime      time.Time         `json:"-"`                  // Calculated expiry time
 }
 
 // HasRefreshToken returns true if the response contains a refresh token
@@ -86,6 +87,24 @@ type Identity struct {
 	Organization     string `json:"organization"`
 }
 
+// UnmarshalJSON decodes an identity. The identities API names the ID field
+// "id" while other responses use "identity_id"; both are accepted.
+func (i *Identity) UnmarshalJSON(data []byte) error {
+	type identity Identity
+	var raw struct {
+		identity
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*i = Identity(raw.identity)
+	if i.IdentityID == "" {
+		i.IdentityID = raw.ID
+	}
+	return nil
+}
+
 // IdentitySet represents a collection of identities
 type IdentitySet struct {
 	Identities []Identity `json:"identities"`
@@ -103,6 +122,13 @@ type TokenInfo struct {
 	IdentitySet []string `json:"identity_set,omitempty"`
 	Email       string   `json:"email,omitempty"`
 	Name        string   `json:"name,omitempty"`
+	Audience    []string `json:"aud,omitempty"`
+	Issuer      string   `json:"iss,omitempty"`
+	IssuedAt    int64    `json:"iat,omitempty"`
+	NotBefore   int64    `json:"nbf,omitempty"`
+
+	// SessionInfo is only set by IntrospectTokenWithSessionInfo
+	SessionInfo *SessionInfo `json:"session_info,omitempty"`
 }
 
 // IsActive returns true if the token is active
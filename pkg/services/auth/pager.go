@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"context"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+// Globus Auth returns these collections in a single response, so each pager
+// is backed by one call to the matching List method.
+
+// AllConsents returns a pager over every consent granted by an identity
+func (c *Client) AllConsents(ctx context.Context, identityID string, options *ListConsentsOptions) *core.Pager[Consent] {
+	return core.NewSlicePager(ctx, func(ctx context.Context) ([]Consent, error) {
+		return c.ListConsents(ctx, identityID, options)
+	})
+}
+
+// AllProjects returns a pager over every project the caller administers
+func (c *Client) AllProjects(ctx context.Context) *core.Pager[Project] {
+	return core.NewSlicePager(ctx, c.ListProjects)
+}
+
+// AllClients returns a pager over every client in the projects the caller
+// administers
+func (c *Client) AllClients(ctx context.Context) *core.Pager[OAuthClient] {
+	return core.NewSlicePager(ctx, c.ListClients)
+}
+
+// AllClientCredentials returns a pager over every credential of a client
+func (c *Client) AllClientCredentials(ctx context.Context, clientID string) *core.Pager[ClientCredential] {
+	return core.NewSlicePager(ctx, func(ctx context.Context) ([]ClientCredential, error) {
+		return c.ListClientCredentials(ctx, clientID)
+	})
+}
+
+// AllScopes returns a pager over every scope matching the options
+func (c *Client) AllScopes(ctx context.Context, options *ListScopesOptions) *core.Pager[ClientScope] {
+	return core.NewSlicePager(ctx, func(ctx context.Context) ([]ClientScope, error) {
+		return c.ListScopes(ctx, options)
+	})
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+)
+
+// NativeAppRedirectURL is the redirect URL for native apps that cannot run a
+// local callback server. Globus Auth shows the authorization code on this
+// page so the user can paste it into the application.
+const NativeAppRedirectURL = "https://auth.globus.org/v2/web/auth-code"
+
+// PKCE holds a Proof Key for Code Exchange (RFC 7636) verifier and its S256
+// challenge. Native apps send the challenge with the authorization request and
+// the verifier with the code exchange, in place of a client secret.
+type PKCE struct {
+	Verifier  string
+	Challenge string
+}
+
+// NewPKCE generates a random PKCE verifier and challenge
+func NewPKCE() (*PKCE, error) {
+	buffer := make([]byte, 32)
+	if _, err := rand.Read(buffer); err != nil {
+		return nil, fmt.Errorf("failed to generate PKCE verifier: %w", err)
+	}
+	verifier := base64.RawURLEncoding.EncodeToString(buffer)
+	sum := sha256.Sum256([]byte(verifier))
+	return &PKCE{
+		Verifier:  verifier,
+		Challenge: base64.RawURLEncoding.EncodeToString(sum[:]),
+	}, nil
+}
+
+// GetNativeAppAuthorizationURL returns a URL for user authorization by a
+// native app. It adds the PKCE challenge and requests refresh tokens
+// (access_type=offline) in addition to everything GetAuthorizationURLWithParameters
+// includes.
+func (c *Client) GetNativeAppAuthorizationURL(state string, pkce *PKCE, params AuthorizationParameters, scopes ...string) string {
+	authURL := c.GetAuthorizationURLWithParameters(state, params, scopes...)
+
+	query := url.Values{}
+	query.Set("code_challenge", pkce.Challenge)
+	query.Set("code_challenge_method", "S256")
+	query.Set("access_type", "offline")
+	return authURL + "&" + query.Encode()
+}
+
+// ExchangeAuthorizationCodeWithPKCE exchanges an authorization code obtained
+// with GetNativeAppAuthorizationURL for tokens
+func (c *Client) ExchangeAuthorizationCodeWithPKCE(ctx context.Context, code, verifier string) (*TokenResponse, error) {
+	if c.RedirectURL == "" {
+		return nil, fmt.Errorf("redirect URL is required for code exchange")
+	}
+	if verifier == "" {
+		return nil, fmt.Errorf("PKCE verifier is required for code exchange")
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.RedirectURL)
+	form.Set("client_id", c.ClientID)
+	form.Set("code_verifier", verifier)
+
+	// Confidential clients still authenticate with their secret
+	if c.ClientSecret != "" {
+		form.Set("client_secret", c.ClientSecret)
+	}
+
+	return c.tokenRequest(ctx, form)
+}
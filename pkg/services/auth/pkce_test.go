@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestNativeAppLogin(t *testing.T) {
+	pkce, err := NewPKCE()
+	if err != nil {
+		t.Fatalf("NewPKCE() error = %v", err)
+	}
+	sum := sha256.Sum256([]byte(pkce.Verifier))
+	if pkce.Challenge != base64.RawURLEncoding.EncodeToString(sum[:]) || len(pkce.Verifier) < 43 {
+		t.Errorf("NewPKCE() = %+v", pkce)
+	}
+	if other, _ := NewPKCE(); other.Verifier == pkce.Verifier {
+		t.Error("NewPKCE() repeated a verifier")
+	}
+
+	server, client := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("code_verifier") != pkce.Verifier || r.Form.Get("redirect_uri") != NativeAppRedirectURL {
+			t.Errorf("Token request form = %v", r.Form)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "a", "refresh_token": "r", "expires_in": 3600, "resource_server": "auth.globus.org"}`))
+	})
+	defer server.Close()
+	client.SetRedirectURL(NativeAppRedirectURL)
+
+	authURL, err := url.Parse(client.GetNativeAppAuthorizationURL("state-1", pkce,
+		AuthorizationParameters{RequiredScopes: []string{"extra"}}, "openid"))
+	if err != nil {
+		t.Fatalf("GetNativeAppAuthorizationURL() is not a URL: %v", err)
+	}
+	query := authURL.Query()
+	if query.Get("code_challenge") != pkce.Challenge || query.Get("code_challenge_method") != "S256" ||
+		query.Get("access_type") != "offline" || query.Get("scope") != "openid extra" {
+		t.Errorf("GetNativeAppAuthorizationURL() query = %v", query)
+	}
+
+	token, err := client.ExchangeAuthorizationCodeWithPKCE(context.Background(), "code-1", pkce.Verifier)
+	if err != nil || token.RefreshToken != "r" {
+		t.Errorf("ExchangeAuthorizationCodeWithPKCE() = %+v, %v", token, err)
+	}
+}
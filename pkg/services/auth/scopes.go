@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"github.com/scttfrdmn/globus-go-sdk/pkg/scopes"
+)
+
+// Scope strings used to request access to Globus Transfer collections
+const (
+	// TransferAllScope is the Transfer service scope that collection scopes depend on
+	TransferAllScope = scopes.TransferAll
+)
+
+// CollectionDataAccessScope returns the data_access scope for a mapped collection
+func CollectionDataAccessScope(collectionID string) string {
+	return scopes.CollectionDataAccess(collectionID).String()
+}
+
+// TransferDataAccessScope returns the Transfer scope with data_access dependencies
+// for the given collections, e.g.
+//
+//	urn:globus:auth:scope:transfer.api.globus.org:all[*https://auth.globus.org/scopes/<id>/data_access]
+//
+// Dependencies are marked optional so that consent can be granted incrementally.
+// With no collection IDs it returns TransferAllScope. Use
+// scopes.TransferWithDataAccess to build on the result.
+func TransferDataAccessScope(collectionIDs ...string) string {
+	return scopes.TransferWithDataAccess(collectionIDs...).String()
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package auth
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestTransferDataAccessScope(t *testing.T) {
+	tests := []struct {
+		name          string
+		collectionIDs []string
+		want          string
+	}{
+		{
+			name: "no collections",
+			want: TransferAllScope,
+		},
+		{
+			name:          "single collection",
+			collectionIDs: []string{"c1"},
+			want:          "urn:globus:auth:scope:transfer.api.globus.org:all[*https://auth.globus.org/scopes/c1/data_access]",
+		},
+		{
+			name:          "multiple collections",
+			collectionIDs: []string{"c1", "", "c2"},
+			want: "urn:globus:auth:scope:transfer.api.globus.org:all[" +
+				"*https://auth.globus.org/scopes/c1/data_access " +
+				"*https://auth.globus.org/scopes/c2/data_access]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TransferDataAccessScope(tt.collectionIDs...); got != tt.want {
+				t.Errorf("TransferDataAccessScope() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransferDataAccessScopeInAuthorizationURL(t *testing.T) {
+	client, err := NewClient(
+		WithClientID("test-client-id"),
+		WithRedirectURL("https://example.com/callback"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create auth client: %v", err)
+	}
+
+	scope := TransferDataAccessScope("c1")
+	authURL, err := url.Parse(client.GetAuthorizationURL("state", scope, ScopeOfflineAccess))
+	if err != nil {
+		t.Fatalf("Failed to parse authorization URL: %v", err)
+	}
+
+	want := scope + " " + ScopeOfflineAccess
+	if got := authURL.Query().Get("scope"); got != want {
+		t.Errorf("scope parameter = %v, want %v", got, want)
+	}
+}
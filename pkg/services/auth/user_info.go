@@ -20,6 +20,37 @@ type UserInfo struct {
 	IdentityProvider  string    `json:"identity_provider"`
 	OrganizationID    string    `json:"organization"`
 	LastAuthenticated time.Time `json:"last_authentication"`
+
+	// IdentitySet lists the user's linked identities. It is only returned
+	// for tokens with the view_identity_set scope (scopes.AuthViewIdentitySet).
+	IdentitySet []IdentitySetEntry `json:"identity_set,omitempty"`
+}
+
+// UnmarshalJSON decodes a userinfo response. Globus Auth sends
+// last_authentication as Unix seconds, or null if the user has not
+// authenticated in the current session; RFC 3339 strings are also accepted.
+func (u *UserInfo) UnmarshalJSON(data []byte) error {
+	type userInfo UserInfo
+	var raw struct {
+		userInfo
+		LastAuthenticated json.RawMessage `json:"last_authentication"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*u = UserInfo(raw.userInfo)
+	if len(raw.LastAuthenticated) == 0 {
+		return nil
+	}
+
+	var seconds *int64
+	if err := json.Unmarshal(raw.LastAuthenticated, &seconds); err == nil {
+		if seconds != nil {
+			u.LastAuthenticated = time.Unix(*seconds, 0)
+		}
+		return nil
+	}
+	return json.Unmarshal(raw.LastAuthenticated, &u.LastAuthenticated)
 }
 
 // GetUserInfo retrieves information about the user associated with the token
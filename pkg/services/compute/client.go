@@ -219,6 +219,9 @@ func (c *Client) ListFunctions(ctx context.Context, options *ListFunctionsOption
 		if options.Marker != "" {
 			query.Set("marker", options.Marker)
 		}
+		if options.Offset > 0 {
+			query.Set("offset", strconv.Itoa(options.Offset))
+		}
 		if options.OrderBy != "" {
 			query.Set("orderby", options.OrderBy)
 		}
@@ -365,6 +368,9 @@ func (c *Client) ListTasks(ctx context.Context, options *TaskListOptions) (*Task
 		if options.Marker != "" {
 			query.Set("marker", options.Marker)
 		}
+		if options.Offset > 0 {
+			query.Set("offset", strconv.Itoa(options.Offset))
+		}
 		if options.Status != "" {
 			query.Set("status", options.Status)
 		}
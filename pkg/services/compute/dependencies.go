@@ -59,6 +59,9 @@ func (c *Client) ListDependencies(ctx context.Context, options *ListDependencies
 		if options.Marker != "" {
 			query.Set("marker", options.Marker)
 		}
+		if options.Offset > 0 {
+			query.Set("offset", fmt.Sprintf("%d", options.Offset))
+		}
 		if options.Search != "" {
 			query.Set("search", options.Search)
 		}
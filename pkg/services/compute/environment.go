@@ -53,6 +53,9 @@ func (c *Client) ListEnvironments(ctx context.Context, options *ListEnvironments
 		if options.Marker != "" {
 			query.Set("marker", options.Marker)
 		}
+		if options.Offset > 0 {
+			query.Set("offset", fmt.Sprintf("%d", options.Offset))
+		}
 		if options.Search != "" {
 			query.Set("search", options.Search)
 		}
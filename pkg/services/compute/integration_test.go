@@ -7,7 +7,9 @@ package compute
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 	"time"
@@ -344,10 +346,9 @@ func TestIntegration_ListFunctions(t *testing.T) {
 	if err != nil {
 		// The ListFunctions endpoint returns 405 Method Not Allowed in some configurations
 		// This is a known issue with the Compute API
-		errorMsg := err.Error()
+		var apiErr core.APIError
 		if core.IsNotFound(err) || core.IsForbidden(err) || core.IsUnauthorized(err) ||
-			(errorMsg != "" && (errorMsg == "unknown_error: Request failed with status code 405 (status: 405)" ||
-				errorMsg == "request failed with status 405: Method Not Allowed")) {
+			(errors.As(err, &apiErr) && apiErr.HTTPStatus() == http.StatusMethodNotAllowed) {
 			t.Logf("Client correctly made the request, but returned expected error: %v", err)
 			t.Logf("This is acceptable for integration testing with limited-permission credentials")
 			return // Skip the rest of the test
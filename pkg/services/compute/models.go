@@ -75,6 +75,7 @@ type FunctionList struct {
 type ListFunctionsOptions struct {
 	PerPage     int    `url:"per_page,omitempty"`
 	Marker      string `url:"marker,omitempty"`
+	Offset      int    `url:"offset,omitempty"`
 	OrderBy     string `url:"orderby,omitempty"`
 	Search      string `url:"search,omitempty"`
 	FilterScope string `url:"filter_scope,omitempty"`
@@ -162,6 +163,7 @@ type ContainerList struct {
 type ListContainersOptions struct {
 	PerPage int    `url:"per_page,omitempty"`
 	Marker  string `url:"marker,omitempty"`
+	Offset  int    `url:"offset,omitempty"`
 	Search  string `url:"search,omitempty"`
 }
 
@@ -250,6 +252,7 @@ type DependencyList struct {
 type ListDependenciesOptions struct {
 	PerPage int    `url:"per_page,omitempty"`
 	Marker  string `url:"marker,omitempty"`
+	Offset  int    `url:"offset,omitempty"`
 	Search  string `url:"search,omitempty"`
 }
 
@@ -300,6 +303,7 @@ type EnvironmentList struct {
 type ListEnvironmentsOptions struct {
 	PerPage int    `url:"per_page,omitempty"`
 	Marker  string `url:"marker,omitempty"`
+	Offset  int    `url:"offset,omitempty"`
 	Search  string `url:"search,omitempty"`
 }
 
@@ -644,6 +648,7 @@ type BatchTaskStatus struct {
 type TaskListOptions struct {
 	PerPage    int    `url:"per_page,omitempty"`
 	Marker     string `url:"marker,omitempty"`
+	Offset     int    `url:"offset,omitempty"`
 	Status     string `url:"status,omitempty"`
 	EndpointID string `url:"endpoint_id,omitempty"`
 	FunctionID string `url:"function_id,omitempty"`
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package compute
+
+import (
+	"context"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+// AllEndpoints returns a pager over every endpoint matching the options. The
+// endpoint list is not paged, so it is fetched with a single ListEndpoints call.
+func (c *Client) AllEndpoints(ctx context.Context, options *ListEndpointsOptions) *core.Pager[ComputeEndpoint] {
+	return core.NewSlicePager(ctx, func(ctx context.Context) ([]ComputeEndpoint, error) {
+		list, err := c.ListEndpoints(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		return list.Endpoints, nil
+	})
+}
+
+// AllFunctions returns a pager over every function matching the options,
+// fetching pages with ListFunctions
+func (c *Client) AllFunctions(ctx context.Context, options *ListFunctionsOptions) *core.Pager[FunctionResponse] {
+	var base ListFunctionsOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[FunctionResponse], error) {
+		pageOptions := base
+		pageOptions.Offset = base.Offset + request.Offset
+		list, err := c.ListFunctions(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[FunctionResponse]{Items: list.Functions, HasNextPage: list.HasNextPage}, nil
+	})
+}
+
+// AllTasks returns a pager over the ID of every task matching the options,
+// fetching pages with ListTasks
+func (c *Client) AllTasks(ctx context.Context, options *TaskListOptions) *core.Pager[string] {
+	var base TaskListOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[string], error) {
+		pageOptions := base
+		pageOptions.Offset = base.Offset + request.Offset
+		list, err := c.ListTasks(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[string]{Items: list.Tasks, HasNextPage: list.HasNextPage}, nil
+	})
+}
+
+// AllContainers returns a pager over every container matching the options,
+// fetching pages with ListContainers
+func (c *Client) AllContainers(ctx context.Context, options *ListContainersOptions) *core.Pager[ContainerResponse] {
+	var base ListContainersOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[ContainerResponse], error) {
+		pageOptions := base
+		pageOptions.Offset = base.Offset + request.Offset
+		list, err := c.ListContainers(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[ContainerResponse]{Items: list.Containers, HasNextPage: list.HasNextPage}, nil
+	})
+}
+
+// AllDependencies returns a pager over every dependency matching the options,
+// fetching pages with ListDependencies
+func (c *Client) AllDependencies(ctx context.Context, options *ListDependenciesOptions) *core.Pager[DependencyResponse] {
+	var base ListDependenciesOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[DependencyResponse], error) {
+		pageOptions := base
+		pageOptions.Offset = base.Offset + request.Offset
+		list, err := c.ListDependencies(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[DependencyResponse]{Items: list.Dependencies, HasNextPage: list.HasNextPage}, nil
+	})
+}
+
+// AllEnvironments returns a pager over every environment matching the
+// options, fetching pages with ListEnvironments
+func (c *Client) AllEnvironments(ctx context.Context, options *ListEnvironmentsOptions) *core.Pager[EnvironmentResponse] {
+	var base ListEnvironmentsOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[EnvironmentResponse], error) {
+		pageOptions := base
+		pageOptions.Offset = base.Offset + request.Offset
+		list, err := c.ListEnvironments(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[EnvironmentResponse]{Items: list.Environments, HasNextPage: list.HasNextPage}, nil
+	})
+}
+
+// AllFunctionDependencies returns a pager over every dependency attached to a
+// function, fetched with a single ListFunctionDependencies call
+func (c *Client) AllFunctionDependencies(ctx context.Context, functionID string) *core.Pager[DependencyResponse] {
+	return core.NewSlicePager(ctx, func(ctx context.Context) ([]DependencyResponse, error) {
+		return c.ListFunctionDependencies(ctx, functionID)
+	})
+}
+
+// AllWorkflows returns a pager over every workflow, fetched with a single
+// ListWorkflows call
+func (c *Client) AllWorkflows(ctx context.Context) *core.Pager[WorkflowResponse] {
+	return core.NewSlicePager(ctx, c.ListWorkflows)
+}
+
+// AllSecrets returns a pager over every secret, fetched with a single
+// ListSecrets call
+func (c *Client) AllSecrets(ctx context.Context) *core.Pager[SecretResponse] {
+	return core.NewSlicePager(ctx, c.ListSecrets)
+}
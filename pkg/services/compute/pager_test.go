@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestAllFunctions(t *testing.T) {
+	var offsets []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/functions" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+
+		list := FunctionList{Functions: []FunctionResponse{{ID: "fn-1"}, {ID: "fn-2"}}, HasNextPage: true}
+		if offset == "2" {
+			list = FunctionList{Functions: []FunctionResponse{{ID: "fn-3"}}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(list)
+	}
+
+	server, client, err := setupMockServer(handler)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer server.Close()
+
+	functions, err := client.AllFunctions(context.Background(), &ListFunctionsOptions{PerPage: 2}).Collect()
+	if err != nil {
+		t.Fatalf("AllFunctions() error = %v", err)
+	}
+	if len(functions) != 3 || functions[2].ID != "fn-3" {
+		t.Errorf("AllFunctions() = %+v", functions)
+	}
+	if len(offsets) != 2 || offsets[0] != "" || offsets[1] != "2" {
+		t.Errorf("Offsets = %v", offsets)
+	}
+}
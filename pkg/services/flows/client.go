@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -92,20 +93,31 @@ func (c *Client) doRequestLowLevel(ctx context.Context, method, path string, que
 	}
 	req.Header.Set("Accept", "application/json")
 
+	var statusCode int
+	var respBody []byte
+	var cause error
 	resp, err := c.Client.Do(ctx, req)
 	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
+		// Error responses are parsed into Flows errors below, wrapping the
+		// core error
+		var coreErr *core.Error
+		if !errors.As(err, &coreErr) {
+			return err
+		}
+		statusCode, respBody, cause = coreErr.StatusCode, coreErr.RawBody, coreErr
+	} else {
+		defer resp.Body.Close()
 
-	// Read response body
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		// Read response body
+		respBody, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+		statusCode = resp.StatusCode
 	}
 
 	// Check for error responses
-	if resp.StatusCode >= 400 {
+	if statusCode >= 400 {
 		// Extract resource ID and type for better error messages
 		resourceID := ""
 		resourceType := ""
@@ -154,11 +166,7 @@ func (c *Client) doRequestLowLevel(ctx context.Context, method, path string, que
 			}
 		}
 
-		// Print debug information during tests
-		fmt.Printf("Error response status: %d, body: %s, resourceID: %s, resourceType: %s\n",
-			resp.StatusCode, string(respBody), resourceID, resourceType)
-
-		return ParseErrorResponse(respBody, resp.StatusCode, resourceID, resourceType)
+		return parseErrorResponse(respBody, statusCode, resourceID, resourceType, cause)
 	}
 
 	// For non-GET requests with no response body, just return nil
@@ -586,86 +594,31 @@ func (c *Client) WaitForRun(ctx context.Context, runID string, pollInterval time
 }
 
 // ListAllFlows lists all flows using pagination, collecting all results.
-// This is a convenience method that uses the FlowIterator internally.
+// This is a convenience method that collects AllFlows.
 func (c *Client) ListAllFlows(ctx context.Context, options *ListFlowsOptions) ([]Flow, error) {
-	iterator := c.GetFlowsIterator(options)
-	var flows []Flow
-
-	for iterator.Next(ctx) {
-		flows = append(flows, *iterator.Flow())
-	}
-
-	if err := iterator.Err(); err != nil {
-		return nil, err
-	}
-
-	return flows, nil
+	return c.AllFlows(ctx, options).Collect()
 }
 
 // ListAllRuns lists all runs using pagination, collecting all results.
-// This is a convenience method that uses the RunIterator internally.
+// This is a convenience method that collects AllRuns.
 func (c *Client) ListAllRuns(ctx context.Context, options *ListRunsOptions) ([]RunResponse, error) {
-	iterator := c.GetRunsIterator(options)
-	var runs []RunResponse
-
-	for iterator.Next(ctx) {
-		runs = append(runs, *iterator.Run())
-	}
-
-	if err := iterator.Err(); err != nil {
-		return nil, err
-	}
-
-	return runs, nil
+	return c.AllRuns(ctx, options).Collect()
 }
 
 // ListAllActionProviders lists all action providers using pagination, collecting all results.
-// This is a convenience method that uses the ActionProviderIterator internally.
+// This is a convenience method that collects AllActionProviders.
 func (c *Client) ListAllActionProviders(ctx context.Context, options *ListActionProvidersOptions) ([]ActionProvider, error) {
-	iterator := c.GetActionProvidersIterator(options)
-	var providers []ActionProvider
-
-	for iterator.Next(ctx) {
-		providers = append(providers, *iterator.ActionProvider())
-	}
-
-	if err := iterator.Err(); err != nil {
-		return nil, err
-	}
-
-	return providers, nil
+	return c.AllActionProviders(ctx, options).Collect()
 }
 
 // ListAllActionRoles lists all action roles for a provider using pagination, collecting all results.
-// This is a convenience method that uses the ActionRoleIterator internally.
+// This is a convenience method that collects AllActionRoles.
 func (c *Client) ListAllActionRoles(ctx context.Context, providerID string) ([]ActionRole, error) {
-	iterator := c.GetActionRolesIterator(providerID, 100)
-	var roles []ActionRole
-
-	for iterator.Next(ctx) {
-		roles = append(roles, *iterator.ActionRole())
-	}
-
-	if err := iterator.Err(); err != nil {
-		return nil, err
-	}
-
-	return roles, nil
+	return c.AllActionRoles(ctx, providerID, defaultIteratorLimit).Collect()
 }
 
 // ListAllRunLogs lists all logs for a run using pagination, collecting all results.
-// This is a convenience method that uses the RunLogIterator internally.
+// This is a convenience method that collects AllRunLogs.
 func (c *Client) ListAllRunLogs(ctx context.Context, runID string) ([]RunLogEntry, error) {
-	iterator := c.GetRunLogsIterator(runID, 100)
-	var entries []RunLogEntry
-
-	for iterator.Next(ctx) {
-		entries = append(entries, *iterator.LogEntry())
-	}
-
-	if err := iterator.Err(); err != nil {
-		return nil, err
-	}
-
-	return entries, nil
+	return c.AllRunLogs(ctx, runID, defaultIteratorLimit).Collect()
 }
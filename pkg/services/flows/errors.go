@@ -11,18 +11,24 @@ package flows
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
 )
 
-// ErrorResponse represents an error response from the Globus Flows API.
+// ErrorResponse represents an error response from the Globus Flows API. It
+// and the errors embedding it match the shared core errors for their status
+// and code, such as core.ErrNotFound.
 type ErrorResponse struct {
-	Code      string `json:"code"`
-	Message   string `json:"message"`
-	RequestID string `json:"request_id,omitempty"`
-	Resource  string `json:"resource,omitempty"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	RequestID  string `json:"request_id,omitempty"`
+	Resource   string `json:"resource,omitempty"`
+	StatusCode int    `json:"-"`
+	RawBody    []byte `json:"-"`
+	Cause      error  `json:"-"`
 }
 
 // Error implements the error interface for ErrorResponse.
@@ -33,6 +39,38 @@ func (e *ErrorResponse) Error() string {
 	return fmt.Sprintf("flows error [%s] %s", e.Code, e.Message)
 }
 
+// Unwrap returns the underlying error, such as the *core.Error the response
+// was read from.
+func (e *ErrorResponse) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether target is a core error matching the error's status or
+// code.
+func (e *ErrorResponse) Is(target error) bool {
+	return core.MatchesError(e.StatusCode, e.Code, target)
+}
+
+// HTTPStatus returns the HTTP status code of the response.
+func (e *ErrorResponse) HTTPStatus() int {
+	return e.StatusCode
+}
+
+// ErrorCode returns the Flows error code.
+func (e *ErrorResponse) ErrorCode() string {
+	return e.Code
+}
+
+// ErrorRequestID returns the Flows request ID.
+func (e *ErrorResponse) ErrorRequestID() string {
+	return e.RequestID
+}
+
+// ResponseBody returns the raw body of the response.
+func (e *ErrorResponse) ResponseBody() []byte {
+	return e.RawBody
+}
+
 // FlowNotFoundError indicates that a requested flow was not found.
 type FlowNotFoundError struct {
 	FlowID string
@@ -100,15 +138,27 @@ func (e *ValidationError) Error() string {
 
 // ParseErrorResponse attempts to parse an HTTP response body into an ErrorResponse.
 func ParseErrorResponse(body []byte, statusCode int, resourceID string, resourceType string) error {
+	return parseErrorResponse(body, statusCode, resourceID, resourceType, nil)
+}
+
+// parseErrorResponse is ParseErrorResponse recording cause, the error the
+// response was read from.
+func parseErrorResponse(body []byte, statusCode int, resourceID string, resourceType string, cause error) error {
 	var errResponse ErrorResponse
 	err := json.Unmarshal(body, &errResponse)
 	if err != nil {
 		// If we can't parse the error response, create a generic one
 		return &ErrorResponse{
-			Code:    fmt.Sprintf("HTTP%d", statusCode),
-			Message: fmt.Sprintf("HTTP %d: %s", statusCode, string(body)),
+			Code:       fmt.Sprintf("HTTP%d", statusCode),
+			Message:    fmt.Sprintf("HTTP %d: %s", statusCode, string(body)),
+			StatusCode: statusCode,
+			RawBody:    body,
+			Cause:      cause,
 		}
 	}
+	errResponse.StatusCode = statusCode
+	errResponse.RawBody = body
+	errResponse.Cause = cause
 
 	// Create appropriate error based on status code and resource type
 	switch statusCode {
@@ -138,10 +188,7 @@ func ParseErrorResponse(body []byte, statusCode int, resourceID string, resource
 				ErrorResponse: &errResponse,
 			}
 		default:
-			return &ErrorResponse{
-				Code:    errResponse.Code,
-				Message: errResponse.Message,
-			}
+			return &errResponse
 		}
 	case http.StatusForbidden:
 		return &ForbiddenError{
@@ -168,12 +215,13 @@ func parseResourceIDs(resourceID string) (string, string) {
 
 // IsFlowNotFoundError checks if an error is a FlowNotFoundError.
 func IsFlowNotFoundError(err error) bool {
-	// Direct type check
-	if _, ok := err.(*FlowNotFoundError); ok {
+	// Type check, including wrapped errors
+	var target *FlowNotFoundError
+	if errors.As(err, &target) {
 		return true
 	}
 
-	// Check if it's a core.Error with 404 status
+	// Check for any other error with a 404 status
 	if core.IsNotFound(err) {
 		return true
 	}
@@ -183,12 +231,13 @@ func IsFlowNotFoundError(err error) bool {
 
 // IsRunNotFoundError checks if an error is a RunNotFoundError.
 func IsRunNotFoundError(err error) bool {
-	// Direct type check
-	if _, ok := err.(*RunNotFoundError); ok {
+	// Type check, including wrapped errors
+	var target *RunNotFoundError
+	if errors.As(err, &target) {
 		return true
 	}
 
-	// Check if it's a core.Error with 404 status
+	// Check for any other error with a 404 status
 	if core.IsNotFound(err) {
 		return true
 	}
@@ -198,12 +247,13 @@ func IsRunNotFoundError(err error) bool {
 
 // IsActionProviderNotFoundError checks if an error is an ActionProviderNotFoundError.
 func IsActionProviderNotFoundError(err error) bool {
-	// Direct type check
-	if _, ok := err.(*ActionProviderNotFoundError); ok {
+	// Type check, including wrapped errors
+	var target *ActionProviderNotFoundError
+	if errors.As(err, &target) {
 		return true
 	}
 
-	// Check if it's a core.Error with 404 status
+	// Check for any other error with a 404 status
 	if core.IsNotFound(err) {
 		return true
 	}
@@ -213,12 +263,13 @@ func IsActionProviderNotFoundError(err error) bool {
 
 // IsActionRoleNotFoundError checks if an error is an ActionRoleNotFoundError.
 func IsActionRoleNotFoundError(err error) bool {
-	// Direct type check
-	if _, ok := err.(*ActionRoleNotFoundError); ok {
+	// Type check, including wrapped errors
+	var target *ActionRoleNotFoundError
+	if errors.As(err, &target) {
 		return true
 	}
 
-	// Check if it's a core.Error with 404 status
+	// Check for any other error with a 404 status
 	if core.IsNotFound(err) {
 		return true
 	}
@@ -228,32 +279,24 @@ func IsActionRoleNotFoundError(err error) bool {
 
 // IsForbiddenError checks if an error is a ForbiddenError.
 func IsForbiddenError(err error) bool {
-	// Direct type check
-	if _, ok := err.(*ForbiddenError); ok {
-		return true
-	}
-
-	// Check if it's a core.Error with 403 status
-	coreErr, ok := err.(*core.Error)
-	if ok && coreErr.StatusCode == http.StatusForbidden {
+	// Type check, including wrapped errors
+	var target *ForbiddenError
+	if errors.As(err, &target) {
 		return true
 	}
 
-	return false
+	// Check for any other error with a 403 status
+	return core.IsForbidden(err)
 }
 
 // IsValidationError checks if an error is a ValidationError.
 func IsValidationError(err error) bool {
-	// Direct type check
-	if _, ok := err.(*ValidationError); ok {
+	// Type check, including wrapped errors
+	var target *ValidationError
+	if errors.As(err, &target) {
 		return true
 	}
 
-	// Check if it's a core.Error with 400 status
-	coreErr, ok := err.(*core.Error)
-	if ok && coreErr.StatusCode == http.StatusBadRequest {
-		return true
-	}
-
-	return false
+	// Check for any other error with a 400 status
+	return errors.Is(err, core.ErrBadRequest)
 }
@@ -3,8 +3,13 @@
 package flows
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"testing"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
 )
 
 func TestParseErrorResponse(t *testing.T) {
@@ -382,3 +387,36 @@ func TestParseResourceIDs(t *testing.T) {
 		t.Errorf("Expected role ID role-123:extra, got %s", roleID)
 	}
 }
+
+func TestFlowsErrorTaxonomy(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code": "NOT_FOUND", "message": "No such flow", "request_id": "req-1"}`))
+	}
+
+	server, client, err := setupMockServer(handler)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer server.Close()
+
+	_, err = client.GetFlow(context.Background(), "missing-flow")
+	var flowErr *FlowNotFoundError
+	if !errors.As(err, &flowErr) || flowErr.FlowID != "missing-flow" {
+		t.Fatalf("GetFlow() error = %v, want a FlowNotFoundError", err)
+	}
+	if !errors.Is(err, core.ErrNotFound) || errors.Is(err, core.ErrForbidden) {
+		t.Errorf("errors.Is(%v) did not match on status", err)
+	}
+	if flowErr.HTTPStatus() != http.StatusNotFound || flowErr.ErrorRequestID() != "req-1" || len(flowErr.ResponseBody()) == 0 {
+		t.Errorf("FlowNotFoundError = %+v", flowErr.ErrorResponse)
+	}
+	if !IsFlowNotFoundError(fmt.Errorf("wrapped: %w", err)) {
+		t.Error("IsFlowNotFoundError() should match a wrapped error")
+	}
+	var coreErr *core.Error
+	if !errors.As(err, &coreErr) || coreErr.StatusCode != http.StatusNotFound {
+		t.Errorf("errors.As(%v, *core.Error) did not find the core error", err)
+	}
+}
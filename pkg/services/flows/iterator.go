@@ -4,400 +4,233 @@ package flows
 
 import (
 	"context"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
 )
 
+// defaultIteratorLimit is the page size the iterators request when none is set
+const defaultIteratorLimit = 100
+
+// pagerIterator adapts a core.Pager to the Next(ctx) style of the iterators
+// in this package. The pager is created on the first call to Next and uses
+// the context passed to it for every page.
+type pagerIterator[T any] struct {
+	newPager func(ctx context.Context) *core.Pager[T]
+	pager    *core.Pager[T]
+	current  *T
+}
+
+// next advances to the next item
+func (i *pagerIterator[T]) next(ctx context.Context) bool {
+	if i.pager == nil {
+		i.pager = i.newPager(ctx)
+	}
+	if !i.pager.Next() {
+		i.current = nil
+		return false
+	}
+	value := i.pager.Value()
+	i.current = &value
+	return true
+}
+
+// err returns the error that stopped the iteration, if any
+func (i *pagerIterator[T]) err() error {
+	if i.pager == nil {
+		return nil
+	}
+	return i.pager.Err()
+}
+
 // FlowIterator provides an iterator for flows that handles pagination automatically.
+// It is a thin wrapper around AllFlows.
 type FlowIterator struct {
-	client   *Client
-	options  *ListFlowsOptions
-	current  *FlowList
-	position int
-	err      error
+	iterator pagerIterator[Flow]
 }
 
 // NewFlowIterator creates a new iterator for flows.
 func NewFlowIterator(client *Client, options *ListFlowsOptions) *FlowIterator {
-	if options == nil {
-		options = &ListFlowsOptions{}
+	var opts ListFlowsOptions
+	if options != nil {
+		opts = *options
 	}
 
 	// Default values for pagination
-	if options.Limit == 0 && options.PerPage == 0 {
-		options.Limit = 100
+	if opts.Limit == 0 && opts.PerPage == 0 {
+		opts.Limit = defaultIteratorLimit
 	}
 
-	return &FlowIterator{
-		client:   client,
-		options:  options,
-		position: -1,
-	}
+	return &FlowIterator{iterator: pagerIterator[Flow]{
+		newPager: func(ctx context.Context) *core.Pager[Flow] {
+			return client.AllFlows(ctx, &opts)
+		},
+	}}
 }
 
 // Next fetches the next flow in the iterator.
 // Returns false when there are no more flows or an error occurred.
 func (i *FlowIterator) Next(ctx context.Context) bool {
-	if i.err != nil {
-		return false
-	}
-
-	// If we're at the end of the current page, or haven't fetched any flows yet
-	if i.current == nil || (i.position >= len(i.current.Flows)-1 && i.current.HadMore) {
-		// If we have a marker from the previous page, update options
-		if i.current != nil && i.current.HadMore {
-			if len(i.current.Flows) > 0 {
-				// Use offset-based pagination
-				i.options.Offset = i.current.Offset + len(i.current.Flows)
-				i.options.Marker = "" // Clear marker if it was set
-			}
-		}
-
-		// Fetch the next page
-		var err error
-		i.current, err = i.client.ListFlows(ctx, i.options)
-		if err != nil {
-			i.err = err
-			return false
-		}
-
-		// Reset position for the new page
-		i.position = -1
-
-		// Check if we got an empty page
-		if len(i.current.Flows) == 0 {
-			return false
-		}
-	}
-
-	// Move to the next item
-	i.position++
-
-	// Check if we've reached the end
-	return i.position < len(i.current.Flows)
+	return i.iterator.next(ctx)
 }
 
 // Flow returns the current flow in the iterator.
 func (i *FlowIterator) Flow() *Flow {
-	if i.current == nil || i.position < 0 || i.position >= len(i.current.Flows) {
-		return nil
-	}
-	return &i.current.Flows[i.position]
+	return i.iterator.current
 }
 
 // Err returns any error that occurred during iteration.
 func (i *FlowIterator) Err() error {
-	return i.err
+	return i.iterator.err()
 }
 
 // RunIterator provides an iterator for flow runs that handles pagination automatically.
+// It is a thin wrapper around AllRuns.
 type RunIterator struct {
-	client   *Client
-	options  *ListRunsOptions
-	current  *RunList
-	position int
-	err      error
+	iterator pagerIterator[RunResponse]
 }
 
 // NewRunIterator creates a new iterator for flow runs.
 func NewRunIterator(client *Client, options *ListRunsOptions) *RunIterator {
-	if options == nil {
-		options = &ListRunsOptions{}
+	var opts ListRunsOptions
+	if options != nil {
+		opts = *options
 	}
 
 	// Default values for pagination
-	if options.Limit == 0 && options.PerPage == 0 {
-		options.Limit = 100
+	if opts.Limit == 0 && opts.PerPage == 0 {
+		opts.Limit = defaultIteratorLimit
 	}
 
-	return &RunIterator{
-		client:   client,
-		options:  options,
-		position: -1,
-	}
+	return &RunIterator{iterator: pagerIterator[RunResponse]{
+		newPager: func(ctx context.Context) *core.Pager[RunResponse] {
+			return client.AllRuns(ctx, &opts)
+		},
+	}}
 }
 
 // Next fetches the next run in the iterator.
 // Returns false when there are no more runs or an error occurred.
 func (i *RunIterator) Next(ctx context.Context) bool {
-	if i.err != nil {
-		return false
-	}
-
-	// If we're at the end of the current page, or haven't fetched any runs yet
-	if i.current == nil || (i.position >= len(i.current.Runs)-1 && i.current.HadMore) {
-		// If we have a marker from the previous page, update options
-		if i.current != nil && i.current.HadMore {
-			if len(i.current.Runs) > 0 {
-				// Use offset-based pagination
-				i.options.Offset = i.current.Offset + len(i.current.Runs)
-				i.options.Marker = "" // Clear marker if it was set
-			}
-		}
-
-		// Fetch the next page
-		var err error
-		i.current, err = i.client.ListRuns(ctx, i.options)
-		if err != nil {
-			i.err = err
-			return false
-		}
-
-		// Reset position for the new page
-		i.position = -1
-
-		// Check if we got an empty page
-		if len(i.current.Runs) == 0 {
-			return false
-		}
-	}
-
-	// Move to the next item
-	i.position++
-
-	// Check if we've reached the end
-	return i.position < len(i.current.Runs)
+	return i.iterator.next(ctx)
 }
 
 // Run returns the current run in the iterator.
 func (i *RunIterator) Run() *RunResponse {
-	if i.current == nil || i.position < 0 || i.position >= len(i.current.Runs) {
-		return nil
-	}
-	return &i.current.Runs[i.position]
+	return i.iterator.current
 }
 
 // Err returns any error that occurred during iteration.
 func (i *RunIterator) Err() error {
-	return i.err
+	return i.iterator.err()
 }
 
 // ActionProviderIterator provides an iterator for action providers that handles pagination automatically.
+// It is a thin wrapper around AllActionProviders.
 type ActionProviderIterator struct {
-	client   *Client
-	options  *ListActionProvidersOptions
-	current  *ActionProviderList
-	position int
-	err      error
+	iterator pagerIterator[ActionProvider]
 }
 
 // NewActionProviderIterator creates a new iterator for action providers.
 func NewActionProviderIterator(client *Client, options *ListActionProvidersOptions) *ActionProviderIterator {
-	if options == nil {
-		options = &ListActionProvidersOptions{}
+	var opts ListActionProvidersOptions
+	if options != nil {
+		opts = *options
 	}
 
 	// Default values for pagination
-	if options.Limit == 0 && options.PerPage == 0 {
-		options.Limit = 100
+	if opts.Limit == 0 && opts.PerPage == 0 {
+		opts.Limit = defaultIteratorLimit
 	}
 
-	return &ActionProviderIterator{
-		client:   client,
-		options:  options,
-		position: -1,
-	}
+	return &ActionProviderIterator{iterator: pagerIterator[ActionProvider]{
+		newPager: func(ctx context.Context) *core.Pager[ActionProvider] {
+			return client.AllActionProviders(ctx, &opts)
+		},
+	}}
 }
 
 // Next fetches the next action provider in the iterator.
-// Returns false when there are no more providers or an error occurred.
+// Returns false when there are no more action providers or an error occurred.
 func (i *ActionProviderIterator) Next(ctx context.Context) bool {
-	if i.err != nil {
-		return false
-	}
-
-	// If we're at the end of the current page, or haven't fetched any providers yet
-	if i.current == nil || (i.position >= len(i.current.ActionProviders)-1 && i.current.HadMore) {
-		// If we have a marker from the previous page, update options
-		if i.current != nil && i.current.HadMore {
-			if len(i.current.ActionProviders) > 0 {
-				// Use offset-based pagination
-				i.options.Offset = i.current.Offset + len(i.current.ActionProviders)
-				i.options.Marker = "" // Clear marker if it was set
-			}
-		}
-
-		// Fetch the next page
-		var err error
-		i.current, err = i.client.ListActionProviders(ctx, i.options)
-		if err != nil {
-			i.err = err
-			return false
-		}
-
-		// Reset position for the new page
-		i.position = -1
-
-		// Check if we got an empty page
-		if len(i.current.ActionProviders) == 0 {
-			return false
-		}
-	}
-
-	// Move to the next item
-	i.position++
-
-	// Check if we've reached the end
-	return i.position < len(i.current.ActionProviders)
+	return i.iterator.next(ctx)
 }
 
 // ActionProvider returns the current action provider in the iterator.
 func (i *ActionProviderIterator) ActionProvider() *ActionProvider {
-	if i.current == nil || i.position < 0 || i.position >= len(i.current.ActionProviders) {
-		return nil
-	}
-	return &i.current.ActionProviders[i.position]
+	return i.iterator.current
 }
 
 // Err returns any error that occurred during iteration.
 func (i *ActionProviderIterator) Err() error {
-	return i.err
+	return i.iterator.err()
 }
 
 // RunLogIterator provides an iterator for run logs that handles pagination automatically.
+// It is a thin wrapper around AllRunLogs.
 type RunLogIterator struct {
-	client   *Client
-	runID    string
-	limit    int
-	offset   int
-	current  *RunLogList
-	position int
-	err      error
+	iterator pagerIterator[RunLogEntry]
 }
 
 // NewRunLogIterator creates a new iterator for run logs.
 func NewRunLogIterator(client *Client, runID string, limit int) *RunLogIterator {
 	if limit <= 0 {
-		limit = 100
+		limit = defaultIteratorLimit
 	}
 
-	return &RunLogIterator{
-		client:   client,
-		runID:    runID,
-		limit:    limit,
-		offset:   0,
-		position: -1,
-	}
+	return &RunLogIterator{iterator: pagerIterator[RunLogEntry]{
+		newPager: func(ctx context.Context) *core.Pager[RunLogEntry] {
+			return client.AllRunLogs(ctx, runID, limit)
+		},
+	}}
 }
 
 // Next fetches the next log entry in the iterator.
 // Returns false when there are no more entries or an error occurred.
 func (i *RunLogIterator) Next(ctx context.Context) bool {
-	if i.err != nil {
-		return false
-	}
-
-	// If we're at the end of the current page, or haven't fetched any logs yet
-	if i.current == nil || (i.position >= len(i.current.Entries)-1 && i.current.HadMore) {
-		// Fetch the next page
-		var err error
-		i.current, err = i.client.GetRunLogs(ctx, i.runID, i.limit, i.offset)
-		if err != nil {
-			i.err = err
-			return false
-		}
-
-		// Update offset for next page
-		i.offset += len(i.current.Entries)
-
-		// Reset position for the new page
-		i.position = -1
-
-		// Check if we got an empty page
-		if len(i.current.Entries) == 0 {
-			return false
-		}
-	}
-
-	// Move to the next item
-	i.position++
-
-	// Check if we've reached the end
-	return i.position < len(i.current.Entries)
+	return i.iterator.next(ctx)
 }
 
 // LogEntry returns the current log entry in the iterator.
 func (i *RunLogIterator) LogEntry() *RunLogEntry {
-	if i.current == nil || i.position < 0 || i.position >= len(i.current.Entries) {
-		return nil
-	}
-	return &i.current.Entries[i.position]
+	return i.iterator.current
 }
 
 // Err returns any error that occurred during iteration.
 func (i *RunLogIterator) Err() error {
-	return i.err
+	return i.iterator.err()
 }
 
 // ActionRoleIterator provides an iterator for action roles that handles pagination automatically.
+// It is a thin wrapper around AllActionRoles.
 type ActionRoleIterator struct {
-	client     *Client
-	providerID string
-	limit      int
-	offset     int
-	current    *ActionRoleList
-	position   int
-	err        error
+	iterator pagerIterator[ActionRole]
 }
 
 // NewActionRoleIterator creates a new iterator for action roles.
 func NewActionRoleIterator(client *Client, providerID string, limit int) *ActionRoleIterator {
 	if limit <= 0 {
-		limit = 100
+		limit = defaultIteratorLimit
 	}
 
-	return &ActionRoleIterator{
-		client:     client,
-		providerID: providerID,
-		limit:      limit,
-		offset:     0,
-		position:   -1,
-	}
+	return &ActionRoleIterator{iterator: pagerIterator[ActionRole]{
+		newPager: func(ctx context.Context) *core.Pager[ActionRole] {
+			return client.AllActionRoles(ctx, providerID, limit)
+		},
+	}}
 }
 
 // Next fetches the next action role in the iterator.
 // Returns false when there are no more roles or an error occurred.
 func (i *ActionRoleIterator) Next(ctx context.Context) bool {
-	if i.err != nil {
-		return false
-	}
-
-	// If we're at the end of the current page, or haven't fetched any roles yet
-	if i.current == nil || (i.position >= len(i.current.ActionRoles)-1 && i.current.HadMore) {
-		// Fetch the next page
-		var err error
-		i.current, err = i.client.ListActionRoles(ctx, i.providerID, i.limit, i.offset)
-		if err != nil {
-			i.err = err
-			return false
-		}
-
-		// Update offset for next page
-		i.offset += len(i.current.ActionRoles)
-
-		// Reset position for the new page
-		i.position = -1
-
-		// Check if we got an empty page
-		if len(i.current.ActionRoles) == 0 {
-			return false
-		}
-	}
-
-	// Move to the next item
-	i.position++
-
-	// Check if we've reached the end
-	return i.position < len(i.current.ActionRoles)
+	return i.iterator.next(ctx)
 }
 
 // ActionRole returns the current action role in the iterator.
 func (i *ActionRoleIterator) ActionRole() *ActionRole {
-	if i.current == nil || i.position < 0 || i.position >= len(i.current.ActionRoles) {
-		return nil
-	}
-	return &i.current.ActionRoles[i.position]
+	return i.iterator.current
 }
 
 // Err returns any error that occurred during iteration.
 func (i *ActionRoleIterator) Err() error {
-	return i.err
+	return i.iterator.err()
 }
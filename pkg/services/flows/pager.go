@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Scott Friedman and Project Contributors
+package flows
+
+import (
+	"context"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+// AllFlows returns a pager over every flow matching the options, fetching
+// pages with ListFlows.
+func (c *Client) AllFlows(ctx context.Context, options *ListFlowsOptions) *core.Pager[Flow] {
+	var base ListFlowsOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[Flow], error) {
+		pageOptions := base
+		pageOptions.Offset = base.Offset + request.Offset
+		list, err := c.ListFlows(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[Flow]{Items: list.Flows, HasNextPage: list.HadMore}, nil
+	})
+}
+
+// AllRuns returns a pager over every run matching the options, fetching
+// pages with ListRuns.
+func (c *Client) AllRuns(ctx context.Context, options *ListRunsOptions) *core.Pager[RunResponse] {
+	var base ListRunsOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[RunResponse], error) {
+		pageOptions := base
+		pageOptions.Offset = base.Offset + request.Offset
+		list, err := c.ListRuns(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[RunResponse]{Items: list.Runs, HasNextPage: list.HadMore}, nil
+	})
+}
+
+// AllActionProviders returns a pager over every action provider matching the
+// options, fetching pages with ListActionProviders.
+func (c *Client) AllActionProviders(ctx context.Context, options *ListActionProvidersOptions) *core.Pager[ActionProvider] {
+	var base ListActionProvidersOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[ActionProvider], error) {
+		pageOptions := base
+		pageOptions.Offset = base.Offset + request.Offset
+		list, err := c.ListActionProviders(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[ActionProvider]{Items: list.ActionProviders, HasNextPage: list.HadMore}, nil
+	})
+}
+
+// AllActionRoles returns a pager over every role of an action provider,
+// fetching pages of up to limit roles with ListActionRoles. A limit of 0
+// uses the service default.
+func (c *Client) AllActionRoles(ctx context.Context, providerID string, limit int) *core.Pager[ActionRole] {
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[ActionRole], error) {
+		list, err := c.ListActionRoles(ctx, providerID, limit, request.Offset)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[ActionRole]{Items: list.ActionRoles, HasNextPage: list.HadMore}, nil
+	})
+}
+
+// AllRunLogs returns a pager over every log entry of a run, fetching pages
+// of up to limit entries with GetRunLogs. A limit of 0 uses the service
+// default.
+func (c *Client) AllRunLogs(ctx context.Context, runID string, limit int) *core.Pager[RunLogEntry] {
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[RunLogEntry], error) {
+		list, err := c.GetRunLogs(ctx, runID, limit, request.Offset)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[RunLogEntry]{Items: list.Entries, HasNextPage: list.HadMore}, nil
+	})
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package groups
+
+import (
+	"context"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+// AllGroups returns a pager over every group matching the options, fetching
+// pages with ListGroups
+func (c *Client) AllGroups(ctx context.Context, options *ListGroupsOptions) *core.Pager[Group] {
+	var base ListGroupsOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[Group], error) {
+		pageOptions := base
+		if request.Marker != "" {
+			pageOptions.PageToken = request.Marker
+		}
+		list, err := c.ListGroups(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[Group]{Items: list.Groups, HasNextPage: list.HasNextPage, NextMarker: list.NextPageToken}, nil
+	})
+}
+
+// AllMembers returns a pager over every member of a group matching the
+// options, fetching pages with ListMembers
+func (c *Client) AllMembers(ctx context.Context, groupID string, options *ListMembersOptions) *core.Pager[Member] {
+	var base ListMembersOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[Member], error) {
+		pageOptions := base
+		if request.Marker != "" {
+			pageOptions.PageToken = request.Marker
+		}
+		list, err := c.ListMembers(ctx, groupID, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[Member]{Items: list.Members, HasNextPage: list.HasNextPage, NextMarker: list.NextPageToken}, nil
+	})
+}
+
+// AllRoles returns a pager over the roles defined for a group. Roles are
+// listed in one request.
+func (c *Client) AllRoles(ctx context.Context, groupID string) *core.Pager[Role] {
+	return core.NewSlicePager(ctx, func(ctx context.Context) ([]Role, error) {
+		list, err := c.ListRoles(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+		return list.Roles, nil
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -93,6 +94,13 @@ func (c *Client) doRequestLowLevel(ctx context.Context, method, path string, que
 
 	resp, err := c.Client.Do(ctx, req)
 	if err != nil {
+		// Surface error responses as Search errors
+		var coreErr *core.Error
+		if errors.As(err, &coreErr) {
+			searchErr := newSearchError(coreErr.StatusCode, coreErr.RawBody)
+			searchErr.Cause = coreErr
+			return searchErr
+		}
 		return err
 	}
 	defer resp.Body.Close()
@@ -105,30 +113,7 @@ func (c *Client) doRequestLowLevel(ctx context.Context, method, path string, que
 
 	// Check for error status codes
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		var errorResp struct {
-			Code      string `json:"code"`
-			Message   string `json:"message"`
-			RequestID string `json:"request_id"`
-		}
-
-		// Try to parse as JSON error
-		if len(respBody) > 0 {
-			if err := json.Unmarshal(respBody, &errorResp); err == nil && errorResp.Message != "" {
-				return &SearchError{
-					Code:      errorResp.Code,
-					Message:   errorResp.Message,
-					Status:    resp.StatusCode,
-					RequestID: errorResp.RequestID,
-				}
-			}
-		}
-
-		// Fallback to generic error message
-		return &SearchError{
-			Code:    fmt.Sprintf("HTTP%d", resp.StatusCode),
-			Message: fmt.Sprintf("request failed with status %d: %s", resp.StatusCode, string(respBody)),
-			Status:  resp.StatusCode,
-		}
+		return newSearchError(resp.StatusCode, respBody)
 	}
 
 	// For empty responses, return early
@@ -3,10 +3,13 @@
 package search
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
 )
 
 // Common error codes for the Search service
@@ -20,15 +23,47 @@ const (
 	ErrorCodeRateLimit              = "RateLimit"
 )
 
-// SearchError represents an error from the Search service
+// SearchError represents an error from the Search service. It matches the
+// shared core errors for its status and code, such as core.ErrNotFound.
 type SearchError struct {
 	Code      string `json:"code"`
 	Message   string `json:"message"`
 	Status    int    `json:"status"`
 	RequestID string `json:"request_id"`
+	RawBody   []byte `json:"-"`
 	Cause     error  `json:"-"`
 }
 
+// newSearchError builds a SearchError from an error response
+func newSearchError(statusCode int, body []byte) *SearchError {
+	var errorResp struct {
+		Code      string `json:"code"`
+		Message   string `json:"message"`
+		RequestID string `json:"request_id"`
+	}
+
+	// Try to parse as JSON error
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &errorResp); err == nil && errorResp.Message != "" {
+			return &SearchError{
+				Code:      errorResp.Code,
+				Message:   errorResp.Message,
+				Status:    statusCode,
+				RequestID: errorResp.RequestID,
+				RawBody:   body,
+			}
+		}
+	}
+
+	// Fallback to generic error message
+	return &SearchError{
+		Code:    fmt.Sprintf("HTTP%d", statusCode),
+		Message: fmt.Sprintf("request failed with status %d: %s", statusCode, string(body)),
+		Status:  statusCode,
+		RawBody: body,
+	}
+}
+
 // Error implements the error interface
 func (e *SearchError) Error() string {
 	if e.RequestID != "" {
@@ -44,6 +79,50 @@ func (e *SearchError) Unwrap() error {
 	return e.Cause
 }
 
+// Is reports whether target is a core error matching the error's status or
+// code
+func (e *SearchError) Is(target error) bool {
+	switch e.Code {
+	case ErrorCodeIndexNotFound, ErrorCodeTaskNotFound:
+		if target == core.ErrNotFound {
+			return true
+		}
+	case ErrorCodePermissionDenied:
+		if target == core.ErrForbidden {
+			return true
+		}
+	case ErrorCodeRateLimit:
+		if target == core.ErrRateLimited {
+			return true
+		}
+	case ErrorCodeIndexExists:
+		if target == core.ErrConflict {
+			return true
+		}
+	}
+	return core.MatchesError(e.Status, e.Code, target)
+}
+
+// HTTPStatus returns the HTTP status code of the response
+func (e *SearchError) HTTPStatus() int {
+	return e.Status
+}
+
+// ErrorCode returns the Search error code
+func (e *SearchError) ErrorCode() string {
+	return e.Code
+}
+
+// ErrorRequestID returns the Search request ID
+func (e *SearchError) ErrorRequestID() string {
+	return e.RequestID
+}
+
+// ResponseBody returns the raw body of the response
+func (e *SearchError) ResponseBody() []byte {
+	return e.RawBody
+}
+
 // IsSearchError checks if an error is a search error
 func IsSearchError(err error) bool {
 	var searchErr *SearchError
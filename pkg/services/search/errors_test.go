@@ -3,9 +3,13 @@
 package search
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
 )
 
 func TestSearchError(t *testing.T) {
@@ -245,3 +249,34 @@ func TestErrorTypeCheckers(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchErrorTaxonomy(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code": "IndexNotFound", "message": "No such index", "request_id": "req-1"}`))
+	}
+
+	server, client, err := setupMockServer(handler)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer server.Close()
+
+	_, err = client.GetIndex(context.Background(), "missing-index")
+	if !errors.Is(err, core.ErrNotFound) || errors.Is(err, core.ErrForbidden) {
+		t.Errorf("GetIndex() error = %v, want a not found error", err)
+	}
+	if !IsIndexNotFoundError(err) {
+		t.Errorf("IsIndexNotFoundError(%v) = false", err)
+	}
+
+	searchErr, ok := AsSearchError(err)
+	if !ok || searchErr.Code != ErrorCodeIndexNotFound || searchErr.RequestID != "req-1" || len(searchErr.RawBody) == 0 {
+		t.Errorf("AsSearchError(%v) = %+v", err, searchErr)
+	}
+	var apiErr core.APIError
+	if !errors.As(err, &apiErr) || apiErr.HTTPStatus() != http.StatusNotFound {
+		t.Errorf("errors.As(%v, core.APIError) = %v", err, apiErr)
+	}
+}
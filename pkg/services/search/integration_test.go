@@ -7,6 +7,7 @@ package search
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"time"
 
 	"github.com/joho/godotenv"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
 	"github.com/scttfrdmn/globus-go-sdk/pkg/services/auth"
 )
 
@@ -104,7 +106,7 @@ func TestIntegration_ListIndexes(t *testing.T) {
 	if err != nil {
 		// Handle different error types with helpful messages
 		if err != nil {
-			if err.Error() == "unknown_error: Request failed with status code 400 (status: 400)" {
+			if errors.Is(err, core.ErrBadRequest) {
 				t.Logf("ERROR: ListIndexes returned 400 Bad Request, which may be due to query parameter issues.")
 				t.Logf("Falling back to listing indexes without query parameters")
 
@@ -112,11 +114,11 @@ func TestIntegration_ListIndexes(t *testing.T) {
 				indexes, err = client.ListIndexes(ctx, nil)
 				if err != nil {
 					// Still failing
-					if err.Error() == "unknown_error: Request failed with status code 403 (status: 403)" {
+					if errors.Is(err, core.ErrForbidden) {
 						t.Logf("PERMISSION ERROR: %v", err)
 						t.Logf("To resolve, set GLOBUS_TEST_SEARCH_TOKEN with a token that has search permissions")
 						return // Skip the rest of the test
-					} else if err.Error() == "unknown_error: Request failed with status code 401 (status: 401)" {
+					} else if errors.Is(err, core.ErrAuthorizationRequired) {
 						t.Logf("AUTHENTICATION ERROR: %v", err)
 						t.Logf("To resolve, provide a valid GLOBUS_TEST_SEARCH_TOKEN with proper permissions")
 						return // Skip the rest of the test
@@ -124,11 +126,11 @@ func TestIntegration_ListIndexes(t *testing.T) {
 						t.Fatalf("ListIndexes failed with unexpected error: %v", err)
 					}
 				}
-			} else if err.Error() == "unknown_error: Request failed with status code 403 (status: 403)" {
+			} else if errors.Is(err, core.ErrForbidden) {
 				t.Logf("PERMISSION ERROR: %v", err)
 				t.Logf("To resolve, set GLOBUS_TEST_SEARCH_TOKEN with a token that has search permissions")
 				return // Skip the rest of the test
-			} else if err.Error() == "unknown_error: Request failed with status code 401 (status: 401)" {
+			} else if errors.Is(err, core.ErrAuthorizationRequired) {
 				t.Logf("AUTHENTICATION ERROR: %v", err)
 				t.Logf("To resolve, provide a valid GLOBUS_TEST_SEARCH_TOKEN with proper permissions")
 				return // Skip the rest of the test
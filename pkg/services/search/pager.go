@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package search
+
+import (
+	"context"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+// AllIndexes returns a pager over every index matching the options,
+// fetching pages with ListIndexes
+func (c *Client) AllIndexes(ctx context.Context, options *ListIndexesOptions) *core.Pager[Index] {
+	var base ListIndexesOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[Index], error) {
+		pageOptions := base
+		if request.Marker != "" {
+			pageOptions.Marker = request.Marker
+		} else {
+			pageOptions.Offset = base.Offset + request.Offset
+		}
+		list, err := c.ListIndexes(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[Index]{Items: list.Indexes, HasNextPage: list.HasMore, NextMarker: list.Marker}, nil
+	})
+}
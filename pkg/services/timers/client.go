@@ -21,7 +21,7 @@ import (
 const DefaultBaseURL = "https://timer.automate.globus.org/api/v1/"
 
 // TimersScope is the required scope for accessing the Timers service
-const TimersScope = "https://auth.globus.org/scopes/a1a171d5-48fb-4c77-a7ba-b8c628c20fd5/timers.api"
+const TimersScope = "https://auth.globus.org/scopes/524230d7-ea86-4a52-8312-86065a9e0417/timer"
 
 // Client provides methods for interacting with the Globus Timers service
 type Client struct {
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package timers
+
+import (
+	"context"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+// nextPage returns the marker for the next page, or an empty string
+func nextPage(marker *string) string {
+	if marker == nil {
+		return ""
+	}
+	return *marker
+}
+
+// AllTimers returns a pager over every timer matching the options, fetching
+// pages with ListTimers
+func (c *Client) AllTimers(ctx context.Context, options *ListTimersOptions) *core.Pager[Timer] {
+	var base ListTimersOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[Timer], error) {
+		pageOptions := base
+		if request.Marker != "" {
+			pageOptions.Marker = &request.Marker
+		}
+		list, err := c.ListTimers(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		next := nextPage(list.NextPage)
+		return &core.Page[Timer]{Items: list.Timers, HasNextPage: list.HasNextPage && next != "", NextMarker: next}, nil
+	})
+}
+
+// AllRuns returns a pager over every run of a timer matching the options,
+// fetching pages with ListRuns
+func (c *Client) AllRuns(ctx context.Context, timerID string, options *ListRunsOptions) *core.Pager[TimerRun] {
+	var base ListRunsOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[TimerRun], error) {
+		pageOptions := base
+		if request.Marker != "" {
+			pageOptions.Marker = &request.Marker
+		}
+		list, err := c.ListRuns(ctx, timerID, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		next := nextPage(list.NextPage)
+		return &core.Page[TimerRun]{Items: list.Runs, HasNextPage: list.HasNextPage && next != "", NextMarker: next}, nil
+	})
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package timers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAllTimers(t *testing.T) {
+	var markers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		marker := r.URL.Query().Get("marker")
+		markers = append(markers, marker)
+
+		next := "page-2"
+		list := TimerList{Timers: []Timer{{ID: "timer-1"}, {ID: "timer-2"}}, HasNextPage: true, NextPage: &next}
+		if marker == "page-2" {
+			list = TimerList{Timers: []Timer{{ID: "timer-3"}}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(list)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(
+		WithAccessToken("test-token"),
+		WithBaseURL(server.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	timers, err := client.AllTimers(context.Background(), nil).Collect()
+	if err != nil {
+		t.Fatalf("AllTimers() error = %v", err)
+	}
+	if len(timers) != 3 || timers[2].ID != "timer-3" {
+		t.Errorf("AllTimers() = %+v", timers)
+	}
+	if len(markers) != 2 || markers[1] != "page-2" {
+		t.Errorf("Markers = %v", markers)
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"context"
+	"strings"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/auth"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/authorizers"
+)
+
+// StoreTokenChanges returns an authorizers.TokenChangeFunc that saves tokens
+// refreshed by an authorizer to storage under resource. The stored scope is
+// kept when the change does not carry scopes. Storage errors are ignored so
+// that a failed save does not fail the request that triggered the refresh.
+func StoreTokenChanges(storage Storage, resource string) authorizers.TokenChangeFunc {
+	return func(_ context.Context, change authorizers.TokenChange) {
+		entry := &Entry{Resource: resource}
+		if existing, err := storage.Lookup(resource); err == nil && existing != nil {
+			entry.RefreshToken = existing.RefreshToken
+			entry.Scope = existing.Scope
+		}
+
+		entry.AccessToken = change.AccessToken
+		if change.RefreshToken != "" {
+			entry.RefreshToken = change.RefreshToken
+		}
+		entry.ExpiresAt = change.ExpiresAt
+		if len(change.Scopes) > 0 {
+			entry.Scope = strings.Join(change.Scopes, " ")
+		}
+
+		_ = storage.Store(entry)
+	}
+}
+
+// Authorizer returns an authorizer for service clients that reads the token
+// for resource from the manager on every request. Tokens close to expiry are
+// refreshed through the manager, so refreshed tokens are stored and shared
+// with every other client using the same manager. When a service rejects the
+// token, the authorizer forces a refresh so the request can be replayed.
+func (m *Manager) Authorizer(resource string) auth.Authorizer {
+	return &managerAuthorizer{manager: m, resource: resource}
+}
+
+// managerAuthorizer is the auth.Authorizer returned by Manager.Authorizer
+type managerAuthorizer struct {
+	manager  *Manager
+	resource string
+}
+
+// GetAuthorizationHeader implements auth.Authorizer
+func (a *managerAuthorizer) GetAuthorizationHeader(ctx ...context.Context) (string, error) {
+	c := context.Background()
+	if len(ctx) > 0 && ctx[0] != nil {
+		c = ctx[0]
+	}
+
+	entry, err := a.manager.GetToken(c, a.resource)
+	if err != nil {
+		return "", err
+	}
+
+	return "Bearer " + entry.AccessToken, nil
+}
+
+// HandleMissingAuthorization implements auth.MissingAuthorizationHandler. It
+// refreshes the token even if it has not expired, unless the stored token
+// has already changed since the rejected request carried it.
+func (a *managerAuthorizer) HandleMissingAuthorization(ctx context.Context) bool {
+	entry, err := a.manager.Storage.Lookup(a.resource)
+	if err != nil || entry == nil {
+		return false
+	}
+
+	rejected, ok := auth.RejectedToken(ctx)
+	if ok && entry.AccessToken != rejected && entry.AccessToken != "" {
+		return true
+	}
+
+	if entry.RefreshToken == "" {
+		return false
+	}
+	if entry.TokenSet == nil {
+		entry.TokenSet = &TokenSet{
+			AccessToken:  entry.AccessToken,
+			RefreshToken: entry.RefreshToken,
+			ExpiresAt:    entry.ExpiresAt,
+			Scope:        entry.Scope,
+			ResourceID:   entry.Resource,
+		}
+	}
+
+	_, err = a.manager.refreshToken(ctx, a.resource, entry)
+	return err == nil
+}
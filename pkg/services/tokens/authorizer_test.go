@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/auth"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/authorizers"
+)
+
+func TestStoreTokenChanges(t *testing.T) {
+	storage := NewMemoryStorage()
+	storage.Store(&Entry{
+		Resource:     "transfer.api.globus.org",
+		AccessToken:  "old-access",
+		RefreshToken: "old-refresh",
+		ExpiresAt:    time.Now().Add(-time.Minute),
+		Scope:        "urn:globus:auth:scope:transfer.api.globus.org:all",
+	})
+
+	refreshFunc := func(ctx context.Context, refreshToken string) (string, string, time.Time, error) {
+		return "new-access", "", time.Now().Add(time.Hour), nil
+	}
+	authorizer := authorizers.NewRefreshableTokenAuthorizer("old-access", "old-refresh", 0, refreshFunc)
+	authorizer.OnTokenChange = StoreTokenChanges(storage, "transfer.api.globus.org")
+
+	header, err := authorizer.GetAuthorizationHeader(context.Background())
+	if err != nil {
+		t.Fatalf("GetAuthorizationHeader() error = %v", err)
+	}
+	if header != "Bearer new-access" {
+		t.Errorf("GetAuthorizationHeader() = %q, want refreshed token", header)
+	}
+
+	entry, err := storage.Lookup("transfer.api.globus.org")
+	if err != nil || entry == nil {
+		t.Fatalf("Lookup() = %v, %v", entry, err)
+	}
+	if entry.AccessToken != "new-access" {
+		t.Errorf("Stored access token = %q, want new-access", entry.AccessToken)
+	}
+	if entry.RefreshToken != "old-refresh" {
+		t.Errorf("Stored refresh token = %q, want old-refresh to be kept", entry.RefreshToken)
+	}
+	if entry.Scope != "urn:globus:auth:scope:transfer.api.globus.org:all" {
+		t.Errorf("Stored scope = %q, want original scope", entry.Scope)
+	}
+}
+
+func TestManagerAuthorizerRefreshesNearExpiry(t *testing.T) {
+	storage := NewMemoryStorage()
+	handler := NewMockRefreshHandler()
+	manager, _ := NewManager(WithStorage(storage), WithRefreshHandler(handler), WithRefreshThreshold(5*time.Minute))
+	storage.Store(&Entry{
+		Resource:     "transfer.api.globus.org",
+		AccessToken:  "expiring-access",
+		RefreshToken: "refresh",
+		ExpiresAt:    time.Now().Add(time.Minute),
+	})
+
+	authorizer := manager.Authorizer("transfer.api.globus.org")
+	header, err := authorizer.GetAuthorizationHeader(context.Background())
+	if err != nil {
+		t.Fatalf("GetAuthorizationHeader() error = %v", err)
+	}
+	if header != "Bearer new-access-token" {
+		t.Errorf("GetAuthorizationHeader() = %q, want refreshed token", header)
+	}
+
+	// Later calls reuse the stored token
+	authorizer.GetAuthorizationHeader(context.Background())
+	if handler.GetCallCount() != 1 {
+		t.Errorf("RefreshToken called %d times, want 1", handler.GetCallCount())
+	}
+	if entry, _ := storage.Lookup("transfer.api.globus.org"); entry.RefreshToken != "new-refresh-token" {
+		t.Errorf("Stored refresh token = %q, want rotated token", entry.RefreshToken)
+	}
+
+	if _, err := manager.Authorizer("groups.api.globus.org").GetAuthorizationHeader(); err == nil {
+		t.Error("GetAuthorizationHeader() for a missing token returned no error")
+	}
+}
+
+func TestManagerAuthorizerRefreshesRejectedToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer new-access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	storage := NewMemoryStorage()
+	handler := NewMockRefreshHandler()
+	manager, _ := NewManager(WithStorage(storage), WithRefreshHandler(handler))
+
+	// The token has not expired but the service no longer accepts it
+	storage.Store(&Entry{
+		Resource:     "transfer.api.globus.org",
+		AccessToken:  "revoked-access",
+		RefreshToken: "refresh",
+		ExpiresAt:    time.Now().Add(time.Hour),
+	})
+
+	client := core.NewClient(core.WithBaseURL(server.URL), core.WithAuthorizer(manager.Authorizer("transfer.api.globus.org")))
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/endpoint_search", nil)
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if handler.GetCallCount() != 1 {
+		t.Errorf("RefreshToken called %d times, want 1", handler.GetCallCount())
+	}
+	if entry, _ := storage.Lookup("transfer.api.globus.org"); entry.AccessToken != "new-access-token" {
+		t.Errorf("Stored access token = %q, want refreshed token", entry.AccessToken)
+	}
+}
+
+func TestManagerAuthorizerComparesRejectedToken(t *testing.T) {
+	storage := NewMemoryStorage()
+	handler := NewMockRefreshHandler()
+	manager, _ := NewManager(WithStorage(storage), WithRefreshHandler(handler))
+	storage.Store(&Entry{
+		Resource:     "transfer.api.globus.org",
+		AccessToken:  "current-access",
+		RefreshToken: "refresh",
+		ExpiresAt:    time.Now().Add(time.Hour),
+	})
+
+	authorizer := manager.Authorizer("transfer.api.globus.org")
+	handlerAuthorizer := authorizer.(auth.MissingAuthorizationHandler)
+
+	// A later request sends the current token while an earlier request that
+	// carried a replaced token is rejected
+	if _, err := authorizer.GetAuthorizationHeader(context.Background()); err != nil {
+		t.Fatalf("GetAuthorizationHeader() error = %v", err)
+	}
+	ctx := auth.WithRejectedAuthorization(context.Background(), "Bearer old-access")
+	if !handlerAuthorizer.HandleMissingAuthorization(ctx) {
+		t.Error("HandleMissingAuthorization() = false for a replaced token")
+	}
+	if handler.GetCallCount() != 0 {
+		t.Errorf("RefreshToken called %d times for a replaced token, want 0", handler.GetCallCount())
+	}
+
+	ctx = auth.WithRejectedAuthorization(context.Background(), "Bearer current-access")
+	if !handlerAuthorizer.HandleMissingAuthorization(ctx) {
+		t.Error("HandleMissingAuthorization() = false for the current token")
+	}
+	if handler.GetCallCount() != 1 {
+		t.Errorf("RefreshToken called %d times for the current token, want 1", handler.GetCallCount())
+	}
+}
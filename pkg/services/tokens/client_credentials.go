@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	coreauth "github.com/scttfrdmn/globus-go-sdk/pkg/core/auth"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/services/auth"
+)
+
+// DefaultClientCredentialsRefreshWindow is how long before expiry a cached
+// client credentials token is replaced when no window is configured
+const DefaultClientCredentialsRefreshWindow = time.Minute
+
+// clientCredentialsKeyPrefix prefixes the storage keys of cached client
+// credentials tokens
+const clientCredentialsKeyPrefix = "client-credentials-"
+
+// ClientCredentialsFetcher requests tokens with the client credentials grant
+type ClientCredentialsFetcher interface {
+	GetClientCredentialsToken(ctx context.Context, scopes ...string) (*auth.TokenResponse, error)
+}
+
+// Ensure that auth.Client implements ClientCredentialsFetcher
+var _ ClientCredentialsFetcher = (*auth.Client)(nil)
+
+// ClientCredentialsSource provides client credentials tokens cached in a
+// Storage, so that every process using the same storage, client ID and scope
+// set shares one token instead of requesting its own. A token is replaced
+// once it is within RefreshWindow of expiring.
+//
+// When the storage implements Locker, as FileStorage and
+// EncryptedFileStorage do, only one process requests a replacement token at a
+// time and the others wait for it and use the result.
+type ClientCredentialsSource struct {
+	Storage       Storage
+	Fetcher       ClientCredentialsFetcher
+	ClientID      string
+	Scopes        []string
+	RefreshWindow time.Duration
+
+	mu sync.Mutex
+}
+
+// NewClientCredentialsSource creates a source that requests tokens for scopes
+// with client and caches them in storage
+func NewClientCredentialsSource(client *auth.Client, storage Storage, scopes ...string) *ClientCredentialsSource {
+	return &ClientCredentialsSource{
+		Storage:       storage,
+		Fetcher:       client,
+		ClientID:      client.ClientID,
+		Scopes:        scopes,
+		RefreshWindow: DefaultClientCredentialsRefreshWindow,
+	}
+}
+
+// ClientCredentialsKey returns the storage key of the cached token for a
+// client ID and scope set. The order of scopes does not matter.
+func ClientCredentialsKey(clientID string, scopes []string) string {
+	unique := make(map[string]bool, len(scopes))
+	sorted := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if scope != "" && !unique[scope] {
+			unique[scope] = true
+			sorted = append(sorted, scope)
+		}
+	}
+	sort.Strings(sorted)
+
+	sum := sha256.Sum256([]byte(strings.Join(sorted, " ")))
+	return clientCredentialsKeyPrefix + clientID + "-" + hex.EncodeToString(sum[:8])
+}
+
+// Key returns the storage key of the source's cached token
+func (s *ClientCredentialsSource) Key() string {
+	return ClientCredentialsKey(s.ClientID, s.Scopes)
+}
+
+// Token returns the cached token, requesting a new one if there is none or
+// it is about to expire
+func (s *ClientCredentialsSource) Token(ctx context.Context) (*Entry, error) {
+	return s.token(ctx, "")
+}
+
+// Authorizer returns an authorizer for service clients that uses the
+// source's token. When a service rejects the token, a new one is requested
+// so the request can be replayed.
+func (s *ClientCredentialsSource) Authorizer() coreauth.Authorizer {
+	return &clientCredentialsAuthorizer{source: s}
+}
+
+// token returns a usable token, treating the access token rejected as
+// unusable
+func (s *ClientCredentialsSource) token(ctx context.Context, rejected string) (*Entry, error) {
+	if s.Storage == nil || s.Fetcher == nil {
+		return nil, errors.New("client credentials source requires a storage and a fetcher")
+	}
+	key := s.Key()
+
+	// Most callers find a usable token without taking any lock
+	entry, err := s.Storage.Lookup(key)
+	if err == nil && s.usable(entry, rejected) {
+		return entry, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if locker, ok := s.Storage.(Locker); ok {
+		unlock, err := locker.Lock(key)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+	}
+
+	// Another goroutine or process may have stored a token while we waited
+	entry, err = s.Storage.Lookup(key)
+	if err != nil {
+		return nil, err
+	}
+	if s.usable(entry, rejected) {
+		return entry, nil
+	}
+
+	response, err := s.Fetcher.GetClientCredentialsToken(ctx, s.Scopes...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client credentials token: %w", err)
+	}
+
+	expiresAt := response.ExpiryTime
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(time.Duration(response.ExpiresIn) * time.Second)
+	}
+	scope := response.Scope
+	if scope == "" {
+		scope = strings.Join(s.Scopes, " ")
+	}
+
+	entry = &Entry{
+		Resource:    key,
+		AccessToken: response.AccessToken,
+		ExpiresAt:   expiresAt,
+		Scope:       scope,
+	}
+	if err := s.Storage.Store(entry); err != nil {
+		return nil, fmt.Errorf("failed to store client credentials token: %w", err)
+	}
+	entry.TokenSet = &TokenSet{
+		AccessToken: entry.AccessToken,
+		ExpiresAt:   entry.ExpiresAt,
+		Scope:       entry.Scope,
+		ResourceID:  entry.Resource,
+	}
+	return entry, nil
+}
+
+// usable reports whether entry holds a token that is neither rejected nor
+// within the refresh window of expiring
+func (s *ClientCredentialsSource) usable(entry *Entry, rejected string) bool {
+	if entry == nil || entry.AccessToken == "" || entry.AccessToken == rejected {
+		return false
+	}
+	return time.Until(entry.ExpiresAt) > s.RefreshWindow
+}
+
+// clientCredentialsAuthorizer is the authorizer returned by
+// ClientCredentialsSource.Authorizer
+type clientCredentialsAuthorizer struct {
+	source *ClientCredentialsSource
+}
+
+// GetAuthorizationHeader implements auth.Authorizer
+func (a *clientCredentialsAuthorizer) GetAuthorizationHeader(ctx ...context.Context) (string, error) {
+	c := context.Background()
+	if len(ctx) > 0 && ctx[0] != nil {
+		c = ctx[0]
+	}
+
+	entry, err := a.source.Token(c)
+	if err != nil {
+		return "", err
+	}
+
+	return "Bearer " + entry.AccessToken, nil
+}
+
+// HandleMissingAuthorization implements auth.MissingAuthorizationHandler by
+// replacing the token the rejected request carried. Without one in ctx, the
+// stored token is replaced.
+func (a *clientCredentialsAuthorizer) HandleMissingAuthorization(ctx context.Context) bool {
+	rejected, ok := coreauth.RejectedToken(ctx)
+	if !ok && a.source.Storage != nil {
+		if entry, err := a.source.Storage.Lookup(a.source.Key()); err == nil && entry != nil {
+			rejected = entry.AccessToken
+		}
+	}
+
+	_, err := a.source.token(ctx, rejected)
+	return err == nil
+}
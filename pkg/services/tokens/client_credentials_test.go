@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+	coreauth "github.com/scttfrdmn/globus-go-sdk/pkg/core/auth"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/services/auth"
+)
+
+// countingFetcher issues numbered client credentials tokens
+type countingFetcher struct {
+	calls     int32
+	expiresIn int
+	// countFile, if set, gets one line appended per request so that requests
+	// can be counted across processes
+	countFile string
+}
+
+func (f *countingFetcher) GetClientCredentialsToken(ctx context.Context, scopes ...string) (*auth.TokenResponse, error) {
+	n := atomic.AddInt32(&f.calls, 1)
+	if f.countFile != "" {
+		file, err := os.OpenFile(f.countFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintln(file, os.Getpid())
+		file.Close()
+	}
+	// Make concurrent requests overlap
+	time.Sleep(20 * time.Millisecond)
+	return &auth.TokenResponse{
+		AccessToken: fmt.Sprintf("cc-token-%d", n),
+		ExpiresIn:   f.expiresIn,
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
+
+func TestClientCredentialsKey(t *testing.T) {
+	a := ClientCredentialsKey("client", []string{"scope-b", "scope-a"})
+	b := ClientCredentialsKey("client", []string{"scope-a", "scope-b", "scope-a"})
+	if a != b {
+		t.Errorf("Keys differ for the same scope set: %q != %q", a, b)
+	}
+	if a == ClientCredentialsKey("client", []string{"scope-a"}) {
+		t.Error("Different scope sets share a key")
+	}
+	if a == ClientCredentialsKey("other-client", []string{"scope-a", "scope-b"}) {
+		t.Error("Different clients share a key")
+	}
+	if strings.ContainsAny(a, "/: ") {
+		t.Errorf("Key %q is not safe for use as a file name", a)
+	}
+}
+
+func TestClientCredentialsSourceSharesToken(t *testing.T) {
+	dir := t.TempDir()
+	fetcher := &countingFetcher{expiresIn: 3600}
+
+	// Separate storages on one directory stand in for separate processes
+	var wg sync.WaitGroup
+	tokens := make([]string, 10)
+	for i := range tokens {
+		storage, err := NewFileStorage(dir)
+		if err != nil {
+			t.Fatalf("NewFileStorage() error = %v", err)
+		}
+		source := &ClientCredentialsSource{Storage: storage, Fetcher: fetcher, ClientID: "client", Scopes: []string{"scope"}}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			entry, err := source.Token(context.Background())
+			if err != nil {
+				t.Errorf("Token() error = %v", err)
+				return
+			}
+			tokens[i] = entry.AccessToken
+		}(i)
+	}
+	wg.Wait()
+
+	if calls := atomic.LoadInt32(&fetcher.calls); calls != 1 {
+		t.Errorf("Fetched %d tokens, want 1", calls)
+	}
+	for _, token := range tokens {
+		if token != "cc-token-1" {
+			t.Errorf("Token() = %q, want the shared token", token)
+		}
+	}
+
+	storage, _ := NewFileStorage(dir)
+	if resources, _ := storage.List(); len(resources) != 1 {
+		t.Errorf("List() = %v, want only the token file", resources)
+	}
+}
+
+func TestClientCredentialsSourceRefreshesBeforeExpiry(t *testing.T) {
+	fetcher := &countingFetcher{expiresIn: 30}
+	source := &ClientCredentialsSource{
+		Storage:       NewMemoryStorage(),
+		Fetcher:       fetcher,
+		ClientID:      "client",
+		Scopes:        []string{"scope"},
+		RefreshWindow: time.Minute,
+	}
+	ctx := context.Background()
+
+	// Tokens inside the refresh window are replaced on every call
+	first, _ := source.Token(ctx)
+	second, _ := source.Token(ctx)
+	if first.AccessToken == second.AccessToken {
+		t.Error("Token() reused a token inside the refresh window")
+	}
+
+	fetcher.expiresIn = 3600
+	third, _ := source.Token(ctx)
+	fourth, _ := source.Token(ctx)
+	if third.AccessToken != fourth.AccessToken {
+		t.Error("Token() replaced a token outside the refresh window")
+	}
+}
+
+func TestClientCredentialsAuthorizerReplacesRejectedToken(t *testing.T) {
+	fetcher := &countingFetcher{expiresIn: 3600}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer cc-token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	source := &ClientCredentialsSource{Storage: NewMemoryStorage(), Fetcher: fetcher, ClientID: "client", Scopes: []string{"scope"}}
+	client := core.NewClient(core.WithBaseURL(server.URL), core.WithAuthorizer(source.Authorizer()))
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/task_list", nil)
+	resp, err := client.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if calls := atomic.LoadInt32(&fetcher.calls); calls != 2 {
+		t.Errorf("Fetched %d tokens, want 2", calls)
+	}
+
+	// A late rejection of the first token does not replace the second
+	handler := source.Authorizer().(coreauth.MissingAuthorizationHandler)
+	ctx := coreauth.WithRejectedAuthorization(context.Background(), "Bearer cc-token-1")
+	if !handler.HandleMissingAuthorization(ctx) {
+		t.Error("HandleMissingAuthorization() = false for a replaced token")
+	}
+	if calls := atomic.LoadInt32(&fetcher.calls); calls != 2 {
+		t.Errorf("Fetched %d tokens after a late rejection, want 2", calls)
+	}
+}
+
+// TestClientCredentialsHelperProcess is run as a child process by
+// TestClientCredentialsSourceAcrossProcesses
+func TestClientCredentialsHelperProcess(t *testing.T) {
+	dir := os.Getenv("GLOBUS_TOKENS_HELPER_DIR")
+	if dir == "" {
+		t.Skip("helper process")
+	}
+	storage, err := NewFileStorage(dir)
+	if err != nil {
+		t.Fatalf("NewFileStorage() error = %v", err)
+	}
+	fetcher := &countingFetcher{expiresIn: 3600, countFile: filepath.Join(dir, ".fetches")}
+	source := &ClientCredentialsSource{Storage: storage, Fetcher: fetcher, ClientID: "client", Scopes: []string{"scope"}}
+	if _, err := source.Token(context.Background()); err != nil {
+		t.Fatalf("Token() error = %v", err)
+	}
+}
+
+func TestClientCredentialsSourceAcrossProcesses(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping multi-process test in short mode")
+	}
+	dir := t.TempDir()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cmd := exec.Command(os.Args[0], "-test.run=^TestClientCredentialsHelperProcess$")
+			cmd.Env = append(os.Environ(), "GLOBUS_TOKENS_HELPER_DIR="+dir)
+			if output, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("Helper process failed: %v\n%s", err, output)
+			}
+		}()
+	}
+	wg.Wait()
+
+	data, err := os.ReadFile(filepath.Join(dir, ".fetches"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if fetches := strings.Count(string(data), "\n"); fetches != 1 {
+		t.Errorf("Processes fetched %d tokens, want 1", fetches)
+	}
+}
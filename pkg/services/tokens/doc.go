@@ -41,12 +41,13 @@ To use the tokens package, you need to create a token storage implementation and
 
 # Token Storage
 
-The package provides two storage implementations:
+The package provides three storage implementations:
 
 1. MemoryStorage: In-memory token storage for testing or simple applications.
 2. FileStorage: File-based token storage for persisting tokens across application restarts.
+3. EncryptedFileStorage: AES-GCM encrypted file storage for shared systems.
 
-Both implementations implement the Storage interface:
+All implementations implement the Storage interface:
 
 	type Storage interface {
 		Store(entry *Entry) error
@@ -55,6 +56,34 @@ Both implementations implement the Storage interface:
 		List() ([]string, error)
 	}
 
+EncryptedFileStorage also implements the core auth.TokenStorage interface.
+Its key comes from a passphrase, an environment variable or a key file, and
+existing plaintext stores can be converted in place:
+
+	key, err := tokens.KeyFromEnv(tokens.DefaultPassphraseEnv)
+	if err != nil {
+		log.Fatalf("No token passphrase: %v", err)
+	}
+	if _, err := tokens.MigratePlaintextStorage("./tokens", key); err != nil {
+		log.Fatalf("Failed to encrypt tokens: %v", err)
+	}
+	storage, err := tokens.NewEncryptedFileStorage("./tokens", key)
+
+# Sharing Tokens with the Python SDK
+
+JSONTokenStorage and SQLTokenStorage use the same token layouts as the Python
+SDK's JSONTokenStorage and SQLiteTokenStorage, so Go and Python tools can share
+one login. Both group tokens by namespace; the storage itself uses the
+"DEFAULT" namespace and Namespace returns a view of another one. SQLTokenStorage
+takes a *sql.DB, so any SQLite driver can be used without the SDK depending on
+one:
+
+	storage, err := tokens.NewJSONTokenStorage(filepath.Join(home, ".globus", "tokens.json"))
+	userTokens := storage.Namespace("userapp-myapp")
+
+WithNamespace gives the same namespaced view over any Storage, prefixing
+resource names for storages without native namespaces.
+
 # Token Management
 
 The Manager handles token storage, retrieval, and automatic refreshing:
@@ -68,6 +97,20 @@ The Manager handles token storage, retrieval, and automatic refreshing:
 	// Use the access token
 	accessToken := entry.TokenSet.AccessToken
 
+# Multi-User Applications
+
+Web services that hold tokens for many users should give each user a
+namespace, so their entries never collide:
+
+	userTokens := tokenManager.ForUser(identityID)
+	entry, err := userTokens.GetToken(ctx, "transfer.api.globus.org")
+
+	// On logout, revoke every token the user granted
+	err = tokenManager.RevokeUser(ctx, identityID)
+
+Background refresh started from the root manager walks every namespace,
+refreshing at most RefreshConcurrency tokens at a time.
+
 # Automatic Token Refreshing
 
 The Manager will automatically refresh tokens when they are close to expiry:
@@ -79,6 +122,34 @@ The Manager will automatically refresh tokens when they are close to expiry:
 	stopRefresh := tokenManager.StartBackgroundRefresh(15 * time.Minute)
 	defer stopRefresh() // Call when done to stop background refresh
 
+# Service Clients
+
+Manager.Authorizer returns an authorizer that reads a resource's token from
+the manager on every request, so service clients never hold a stale token:
+
+	client, err := transfer.NewClient(
+		transfer.WithAuthorizer(tokenManager.Authorizer("transfer.api.globus.org")),
+	)
+
+When a service rejects the token with a 401, the authorizer refreshes it
+through the manager and the request is replayed. The SDKConfig methods
+NewTransferClientWithManager, NewGroupsClientWithManager and friends build
+clients this way.
+
+# Sharing Client Credentials Tokens
+
+Many short-lived processes using one service account can share a client
+credentials token instead of each requesting their own:
+
+	storage, err := tokens.NewFileStorage("/var/lib/myjobs/tokens")
+	source := tokens.NewClientCredentialsSource(authClient, storage, transfer.TransferScope)
+
+	client, err := transfer.NewClient(transfer.WithAuthorizer(source.Authorizer()))
+
+Tokens are cached per client ID and scope set and replaced shortly before
+they expire. FileStorage and EncryptedFileStorage lock the token across
+processes while it is replaced, so only one process calls Globus Auth.
+
 # Thread Safety
 
 All implementations in this package are thread-safe and can be used concurrently.
@@ -0,0 +1,620 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/auth"
+)
+
+// DefaultPassphraseEnv is the environment variable read by KeyFromEnv when no
+// name is given
+const DefaultPassphraseEnv = "GLOBUS_TOKEN_PASSPHRASE"
+
+// encryptedFileExt is the extension of files written by EncryptedFileStorage
+const encryptedFileExt = ".enc"
+
+// Encrypted file layout: magic | kdf | salt | nonce | ciphertext
+var encryptedMagic = []byte("GTS1")
+
+const (
+	kdfRaw    byte = 0
+	kdfScrypt byte = 1
+
+	keySize  = 32
+	saltSize = 16
+)
+
+// scrypt parameters used to derive keys from passphrases
+var (
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+var (
+	// ErrNoEncryptionKey is returned when no usable encryption key is configured
+	ErrNoEncryptionKey = errors.New("no encryption key configured")
+
+	// ErrDecryptionFailed is returned when none of the configured keys can
+	// decrypt a token file
+	ErrDecryptionFailed = errors.New("token file could not be decrypted with the configured keys")
+)
+
+// EncryptionKey is the secret used by EncryptedFileStorage. It is either a
+// raw 256-bit key or a passphrase from which keys are derived with scrypt.
+type EncryptionKey struct {
+	raw        []byte
+	passphrase []byte
+}
+
+// PassphraseKey returns a key derived from a passphrase with scrypt
+func PassphraseKey(passphrase string) (EncryptionKey, error) {
+	if passphrase == "" {
+		return EncryptionKey{}, ErrNoEncryptionKey
+	}
+	return EncryptionKey{passphrase: []byte(passphrase)}, nil
+}
+
+// RawKey returns a key that uses 32 bytes of key material directly
+func RawKey(key []byte) (EncryptionKey, error) {
+	if len(key) != keySize {
+		return EncryptionKey{}, fmt.Errorf("encryption key must be %d bytes, got %d", keySize, len(key))
+	}
+	return EncryptionKey{raw: append([]byte(nil), key...)}, nil
+}
+
+// KeyFromEnv returns a passphrase key read from the named environment
+// variable, or from DefaultPassphraseEnv if name is empty
+func KeyFromEnv(name string) (EncryptionKey, error) {
+	if name == "" {
+		name = DefaultPassphraseEnv
+	}
+	passphrase := os.Getenv(name)
+	if passphrase == "" {
+		return EncryptionKey{}, fmt.Errorf("%w: environment variable %s is not set", ErrNoEncryptionKey, name)
+	}
+	return PassphraseKey(passphrase)
+}
+
+// KeyFromFile reads a base64-encoded 32-byte key from path. The file must not
+// be readable by group or others.
+func KeyFromFile(path string) (EncryptionKey, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return EncryptionKey{}, fmt.Errorf("failed to read key file: %w", err)
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return EncryptionKey{}, fmt.Errorf("key file %s must not be accessible by group or others (mode %s)", path, info.Mode().Perm())
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return EncryptionKey{}, fmt.Errorf("failed to read key file: %w", err)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return EncryptionKey{}, fmt.Errorf("key file %s is not valid base64: %w", path, err)
+	}
+	return RawKey(key)
+}
+
+// GenerateKeyFile writes a new random key to path with mode 0600 and returns
+// it. An existing file is not overwritten.
+func GenerateKeyFile(path string) (EncryptionKey, error) {
+	key := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return EncryptionKey{}, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return EncryptionKey{}, fmt.Errorf("failed to create key directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return EncryptionKey{}, fmt.Errorf("failed to create key file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(base64.StdEncoding.EncodeToString(key) + "\n"); err != nil {
+		return EncryptionKey{}, fmt.Errorf("failed to write key file: %w", err)
+	}
+	return RawKey(key)
+}
+
+// isZero reports whether the key has no key material
+func (k EncryptionKey) isZero() bool {
+	return len(k.raw) == 0 && len(k.passphrase) == 0
+}
+
+// kdf returns the key derivation identifier stored in file headers
+func (k EncryptionKey) kdf() byte {
+	if len(k.raw) > 0 {
+		return kdfRaw
+	}
+	return kdfScrypt
+}
+
+// storedToken is the plaintext form of an encrypted token file. Its JSON
+// form is a superset of Entry, so FileStorage files can be migrated as is.
+type storedToken struct {
+	Resource     string    `json:"resource"`
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	Scope        string    `json:"scope,omitempty"`
+	ResourceID   string    `json:"resource_id,omitempty"`
+}
+
+// EncryptedFileStorage stores each token in its own AES-256-GCM encrypted
+// file. It implements both Storage and the core auth.TokenStorage interface.
+//
+// Files are always written with the primary key. Additional keys are only
+// used for reading, which allows a key to be replaced gradually or all at
+// once with RotateKey.
+type EncryptedFileStorage struct {
+	directory string
+	keys      []EncryptionKey
+
+	// writeSalt is reused for all writes with a passphrase key so the
+	// expensive derivation only happens once per storage
+	writeSalt []byte
+	derived   map[string][]byte
+	derivedMu sync.Mutex
+
+	mutex sync.RWMutex
+}
+
+// NewEncryptedFileStorage creates an encrypted token storage in directory.
+// Tokens are written with key; oldKeys are tried when reading files that
+// were written before a key change.
+func NewEncryptedFileStorage(directory string, key EncryptionKey, oldKeys ...EncryptionKey) (*EncryptedFileStorage, error) {
+	if key.isZero() {
+		return nil, ErrNoEncryptionKey
+	}
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	s := &EncryptedFileStorage{
+		directory: directory,
+		derived:   make(map[string][]byte),
+	}
+	if err := s.setKeys(append([]EncryptionKey{key}, oldKeys...)); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// setKeys replaces the configured keys and picks a new write salt
+func (s *EncryptedFileStorage) setKeys(keys []EncryptionKey) error {
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return fmt.Errorf("failed to generate salt: %w", err)
+	}
+	s.keys = keys
+	s.writeSalt = salt
+	return nil
+}
+
+// Store implements Storage.Store
+func (s *EncryptedFileStorage) Store(entry *Entry) error {
+	if entry == nil || entry.Resource == "" {
+		return errors.New("invalid token entry")
+	}
+
+	token := storedToken{
+		Resource:     entry.Resource,
+		AccessToken:  entry.AccessToken,
+		RefreshToken: entry.RefreshToken,
+		ExpiresAt:    entry.ExpiresAt,
+		Scope:        entry.Scope,
+	}
+	if entry.TokenSet != nil {
+		token.AccessToken = entry.TokenSet.AccessToken
+		token.RefreshToken = entry.TokenSet.RefreshToken
+		token.ExpiresAt = entry.TokenSet.ExpiresAt
+		token.Scope = entry.TokenSet.Scope
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.write(token)
+}
+
+// Lookup implements Storage.Lookup. It returns nil, nil if the resource has
+// no stored token.
+func (s *EncryptedFileStorage) Lookup(resource string) (*Entry, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	token, err := s.read(resource)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil // Not found, but not an error
+		}
+		return nil, err
+	}
+
+	entry := &Entry{
+		Resource:     token.Resource,
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		ExpiresAt:    token.ExpiresAt,
+		Scope:        token.Scope,
+	}
+	entry.TokenSet = &TokenSet{
+		AccessToken:  entry.AccessToken,
+		RefreshToken: entry.RefreshToken,
+		ExpiresAt:    entry.ExpiresAt,
+		Scope:        entry.Scope,
+		ResourceID:   entry.Resource,
+	}
+	return entry, nil
+}
+
+// Delete implements Storage.Delete
+func (s *EncryptedFileStorage) Delete(resource string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	err := os.Remove(s.path(resource))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete token file: %w", err)
+	}
+	return nil
+}
+
+// List implements Storage.List
+func (s *EncryptedFileStorage) List() ([]string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.list()
+}
+
+// StoreToken implements auth.TokenStorage
+func (s *EncryptedFileStorage) StoreToken(_ context.Context, key string, token auth.TokenInfo) error {
+	if key == "" {
+		return errors.New("token key is required")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.write(storedToken{
+		Resource:     key,
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		ExpiresAt:    token.ExpiresAt,
+		Scope:        strings.Join(token.Scopes, " "),
+		ResourceID:   token.ResourceID,
+	})
+}
+
+// GetToken implements auth.TokenStorage
+func (s *EncryptedFileStorage) GetToken(_ context.Context, key string) (auth.TokenInfo, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	token, err := s.read(key)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return auth.TokenInfo{}, auth.ErrTokenNotFound
+		}
+		return auth.TokenInfo{}, err
+	}
+
+	return auth.TokenInfo{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		ExpiresAt:    token.ExpiresAt,
+		Scopes:       strings.Fields(token.Scope),
+		ResourceID:   token.ResourceID,
+	}, nil
+}
+
+// DeleteToken implements auth.TokenStorage
+func (s *EncryptedFileStorage) DeleteToken(_ context.Context, key string) error {
+	return s.Delete(key)
+}
+
+// ListTokens implements auth.TokenStorage
+func (s *EncryptedFileStorage) ListTokens(_ context.Context) ([]string, error) {
+	return s.List()
+}
+
+// RotateKey re-encrypts every stored token with newKey and makes it the
+// primary key. Previous keys are kept for reading so that a concurrent
+// writer using an old key does not lock out its own files.
+func (s *EncryptedFileStorage) RotateKey(newKey EncryptionKey) error {
+	if newKey.isZero() {
+		return ErrNoEncryptionKey
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	resources, err := s.list()
+	if err != nil {
+		return err
+	}
+
+	// Decrypt everything before writing anything, so a file that cannot be
+	// read does not leave the store half rotated
+	tokens := make([]storedToken, 0, len(resources))
+	for _, resource := range resources {
+		token, err := s.read(resource)
+		if err != nil {
+			return fmt.Errorf("failed to read %s for key rotation: %w", resource, err)
+		}
+		tokens = append(tokens, token)
+	}
+
+	previous := s.keys
+	if err := s.setKeys(append([]EncryptionKey{newKey}, previous...)); err != nil {
+		return err
+	}
+
+	for _, token := range tokens {
+		if err := s.write(token); err != nil {
+			return fmt.Errorf("failed to re-encrypt %s: %w", token.Resource, err)
+		}
+	}
+	return nil
+}
+
+// path returns the file path for a resource. Resource names are encoded so
+// that any string, including URLs, maps to a single safe file name.
+func (s *EncryptedFileStorage) path(resource string) string {
+	return filepath.Join(s.directory, base64.RawURLEncoding.EncodeToString([]byte(resource))+encryptedFileExt)
+}
+
+// list returns the resources stored in the directory
+func (s *EncryptedFileStorage) list() ([]string, error) {
+	entries, err := os.ReadDir(s.directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read storage directory: %w", err)
+	}
+
+	resources := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, encryptedFileExt) {
+			continue
+		}
+		resource, err := base64.RawURLEncoding.DecodeString(strings.TrimSuffix(name, encryptedFileExt))
+		if err != nil {
+			continue
+		}
+		resources = append(resources, string(resource))
+	}
+	return resources, nil
+}
+
+// write encrypts a token with the primary key and atomically replaces its file
+func (s *EncryptedFileStorage) write(token storedToken) error {
+	plaintext, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to marshal token entry: %w", err)
+	}
+
+	key := s.keys[0]
+	salt := make([]byte, saltSize)
+	if key.kdf() == kdfScrypt {
+		copy(salt, s.writeSalt)
+	}
+	header := append(append(append([]byte(nil), encryptedMagic...), key.kdf()), salt...)
+
+	aead, err := s.aead(key, salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	data := append(header, nonce...)
+	data = aead.Seal(data, nonce, plaintext, additionalData(header, token.Resource))
+
+	return writeFileAtomic(s.path(token.Resource), data)
+}
+
+// read decrypts the token file for a resource with the first key that works
+func (s *EncryptedFileStorage) read(resource string) (storedToken, error) {
+	data, err := os.ReadFile(s.path(resource))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return storedToken{}, err
+		}
+		return storedToken{}, fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	headerSize := len(encryptedMagic) + 1 + saltSize
+	if len(data) < headerSize || !bytes.Equal(data[:len(encryptedMagic)], encryptedMagic) {
+		return storedToken{}, fmt.Errorf("token file for %s is not an encrypted token file", resource)
+	}
+	header := data[:headerSize]
+	kdf := header[len(encryptedMagic)]
+	salt := header[len(encryptedMagic)+1:]
+
+	for _, key := range s.keys {
+		if key.kdf() != kdf {
+			continue
+		}
+		aead, err := s.aead(key, salt)
+		if err != nil {
+			return storedToken{}, err
+		}
+		body := data[headerSize:]
+		if len(body) < aead.NonceSize() {
+			break
+		}
+		plaintext, err := aead.Open(nil, body[:aead.NonceSize()], body[aead.NonceSize():], additionalData(header, resource))
+		if err != nil {
+			continue
+		}
+
+		var token storedToken
+		if err := json.Unmarshal(plaintext, &token); err != nil {
+			return storedToken{}, fmt.Errorf("failed to unmarshal token entry: %w", err)
+		}
+		return token, nil
+	}
+
+	return storedToken{}, fmt.Errorf("%w: %s", ErrDecryptionFailed, resource)
+}
+
+// aead returns the AES-GCM cipher for a key and salt, deriving and caching
+// passphrase keys as needed
+func (s *EncryptedFileStorage) aead(key EncryptionKey, salt []byte) (cipher.AEAD, error) {
+	material := key.raw
+	if key.kdf() == kdfScrypt {
+		s.derivedMu.Lock()
+		defer s.derivedMu.Unlock()
+
+		cacheKey := string(key.passphrase) + "\x00" + string(salt)
+		if cached, ok := s.derived[cacheKey]; ok {
+			material = cached
+		} else {
+			derived, err := scrypt.Key(key.passphrase, salt, scryptN, scryptR, scryptP, keySize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to derive key: %w", err)
+			}
+			s.derived[cacheKey] = derived
+			material = derived
+		}
+	}
+
+	block, err := aes.NewCipher(material)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// additionalData binds the ciphertext to its header and resource name, so a
+// file cannot be swapped for another resource's file
+func additionalData(header []byte, resource string) []byte {
+	return append(append([]byte(nil), header...), resource...)
+}
+
+// writeFileAtomic writes data to a temporary file and renames it into place
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create token file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to set token file permissions: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace token file: %w", err)
+	}
+	return nil
+}
+
+// MigratePlaintextStorage encrypts the plaintext token files in directory in
+// place and returns the number of files converted. It understands the files
+// written by FileStorage and by the core auth.FileTokenStorage. Each
+// plaintext file is removed only after its encrypted copy has been written.
+func MigratePlaintextStorage(directory string, key EncryptionKey) (int, error) {
+	storage, err := NewEncryptedFileStorage(directory, key)
+	if err != nil {
+		return 0, err
+	}
+
+	files, err := os.ReadDir(directory)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read storage directory: %w", err)
+	}
+
+	migrated := 0
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || strings.HasPrefix(name, ".") || strings.HasSuffix(name, encryptedFileExt) {
+			continue
+		}
+
+		path := filepath.Join(directory, name)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return migrated, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+
+		token, err := parsePlaintextToken(name, data)
+		if err != nil {
+			return migrated, fmt.Errorf("failed to migrate %s: %w", name, err)
+		}
+
+		storage.mutex.Lock()
+		err = storage.write(token)
+		storage.mutex.Unlock()
+		if err != nil {
+			return migrated, fmt.Errorf("failed to migrate %s: %w", name, err)
+		}
+		if err := os.Remove(path); err != nil {
+			return migrated, fmt.Errorf("failed to remove plaintext %s: %w", name, err)
+		}
+		migrated++
+	}
+
+	return migrated, nil
+}
+
+// parsePlaintextToken parses a FileStorage entry or an auth.FileTokenStorage
+// token. FileStorage entries carry their resource; auth tokens are keyed by
+// file name.
+func parsePlaintextToken(name string, data []byte) (storedToken, error) {
+	var plain struct {
+		storedToken
+		Scopes []string `json:"scopes"`
+	}
+	if err := json.Unmarshal(data, &plain); err != nil {
+		return storedToken{}, err
+	}
+
+	token := plain.storedToken
+	if token.Resource == "" {
+		token.Resource = strings.TrimSuffix(name, ".json")
+	}
+	if token.Scope == "" && len(plain.Scopes) > 0 {
+		token.Scope = strings.Join(plain.Scopes, " ")
+	}
+	if token.AccessToken == "" && token.RefreshToken == "" {
+		return storedToken{}, errors.New("file does not contain a token")
+	}
+	return token, nil
+}
+
+// Ensure EncryptedFileStorage implements both storage interfaces
+var (
+	_ Storage           = (*EncryptedFileStorage)(nil)
+	_ auth.TokenStorage = (*EncryptedFileStorage)(nil)
+)
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/auth"
+)
+
+func init() {
+	// Keep passphrase derivation fast in tests
+	scryptN = 1 << 10
+}
+
+func mustPassphraseKey(t *testing.T, passphrase string) EncryptionKey {
+	t.Helper()
+	key, err := PassphraseKey(passphrase)
+	if err != nil {
+		t.Fatalf("PassphraseKey() error = %v", err)
+	}
+	return key
+}
+
+func TestEncryptedFileStorageRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	storage, err := NewEncryptedFileStorage(dir, mustPassphraseKey(t, "correct horse"))
+	if err != nil {
+		t.Fatalf("NewEncryptedFileStorage() error = %v", err)
+	}
+
+	expires := time.Now().Add(time.Hour).Truncate(time.Second)
+	if err := storage.Store(&Entry{
+		Resource:     "https://transfer.api.globus.org",
+		AccessToken:  "secret-access",
+		RefreshToken: "secret-refresh",
+		ExpiresAt:    expires,
+		Scope:        "urn:globus:auth:scope:transfer.api.globus.org:all",
+	}); err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+
+	// The refresh token must not appear in the file
+	files, _ := os.ReadDir(dir)
+	if len(files) != 1 {
+		t.Fatalf("Storage directory has %d files, want 1", len(files))
+	}
+	raw, _ := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	if bytes.Contains(raw, []byte("secret-refresh")) {
+		t.Error("Token file contains the plaintext refresh token")
+	}
+
+	entry, err := storage.Lookup("https://transfer.api.globus.org")
+	if err != nil || entry == nil {
+		t.Fatalf("Lookup() = %v, %v", entry, err)
+	}
+	if entry.RefreshToken != "secret-refresh" || !entry.ExpiresAt.Equal(expires) || entry.TokenSet == nil {
+		t.Errorf("Lookup() = %+v", entry)
+	}
+
+	// The same file is visible through auth.TokenStorage
+	ctx := context.Background()
+	info, err := storage.GetToken(ctx, "https://transfer.api.globus.org")
+	if err != nil {
+		t.Fatalf("GetToken() error = %v", err)
+	}
+	if info.AccessToken != "secret-access" || len(info.Scopes) != 1 {
+		t.Errorf("GetToken() = %+v", info)
+	}
+
+	if err := storage.StoreToken(ctx, "groups", auth.TokenInfo{AccessToken: "groups-token", ResourceID: "groups.api.globus.org"}); err != nil {
+		t.Fatalf("StoreToken() error = %v", err)
+	}
+	keys, err := storage.ListTokens(ctx)
+	if err != nil || len(keys) != 2 {
+		t.Errorf("ListTokens() = %v, %v, want 2 keys", keys, err)
+	}
+
+	if err := storage.DeleteToken(ctx, "groups"); err != nil {
+		t.Fatalf("DeleteToken() error = %v", err)
+	}
+	if _, err := storage.GetToken(ctx, "groups"); !errors.Is(err, auth.ErrTokenNotFound) {
+		t.Errorf("GetToken() after delete error = %v, want ErrTokenNotFound", err)
+	}
+	if entry, err := storage.Lookup("groups"); entry != nil || err != nil {
+		t.Errorf("Lookup() after delete = %v, %v, want nil, nil", entry, err)
+	}
+}
+
+func TestEncryptedFileStorageKeys(t *testing.T) {
+	dir := t.TempDir()
+	oldKey := mustPassphraseKey(t, "old passphrase")
+	storage, err := NewEncryptedFileStorage(dir, oldKey)
+	if err != nil {
+		t.Fatalf("NewEncryptedFileStorage() error = %v", err)
+	}
+	storage.Store(&Entry{Resource: "auth", AccessToken: "a", RefreshToken: "r"})
+
+	// A different key cannot read the file
+	wrong, _ := NewEncryptedFileStorage(dir, mustPassphraseKey(t, "wrong"))
+	if _, err := wrong.Lookup("auth"); !errors.Is(err, ErrDecryptionFailed) {
+		t.Errorf("Lookup() with wrong key error = %v, want ErrDecryptionFailed", err)
+	}
+
+	// A new primary key can read files written with an old key
+	keyFile := filepath.Join(t.TempDir(), "token.key")
+	newKey, err := GenerateKeyFile(keyFile)
+	if err != nil {
+		t.Fatalf("GenerateKeyFile() error = %v", err)
+	}
+	withOld, _ := NewEncryptedFileStorage(dir, newKey, oldKey)
+	if entry, err := withOld.Lookup("auth"); err != nil || entry == nil || entry.RefreshToken != "r" {
+		t.Fatalf("Lookup() with old key = %v, %v", entry, err)
+	}
+
+	// After rotation the new key alone is enough
+	if err := storage.RotateKey(newKey); err != nil {
+		t.Fatalf("RotateKey() error = %v", err)
+	}
+	loaded, err := KeyFromFile(keyFile)
+	if err != nil {
+		t.Fatalf("KeyFromFile() error = %v", err)
+	}
+	rotated, _ := NewEncryptedFileStorage(dir, loaded)
+	if entry, err := rotated.Lookup("auth"); err != nil || entry == nil || entry.AccessToken != "a" {
+		t.Errorf("Lookup() after rotation = %v, %v", entry, err)
+	}
+
+	if err := os.Chmod(keyFile, 0644); err != nil {
+		t.Fatalf("Chmod() error = %v", err)
+	}
+	if _, err := KeyFromFile(keyFile); err == nil {
+		t.Error("KeyFromFile() accepted a world-readable key file")
+	}
+
+	t.Setenv(DefaultPassphraseEnv, "")
+	if _, err := KeyFromEnv(""); !errors.Is(err, ErrNoEncryptionKey) {
+		t.Errorf("KeyFromEnv() error = %v, want ErrNoEncryptionKey", err)
+	}
+	t.Setenv(DefaultPassphraseEnv, "old passphrase")
+	envKey, err := KeyFromEnv("")
+	if err != nil {
+		t.Fatalf("KeyFromEnv() error = %v", err)
+	}
+	fromEnv, _ := NewEncryptedFileStorage(dir, envKey)
+	if _, err := fromEnv.Lookup("auth"); !errors.Is(err, ErrDecryptionFailed) {
+		t.Errorf("Lookup() with rotated-out key error = %v, want ErrDecryptionFailed", err)
+	}
+}
+
+func TestMigratePlaintextStorage(t *testing.T) {
+	dir := t.TempDir()
+
+	plain, err := NewFileStorage(dir)
+	if err != nil {
+		t.Fatalf("NewFileStorage() error = %v", err)
+	}
+	plain.Store(&Entry{Resource: "transfer", AccessToken: "t-access", RefreshToken: "t-refresh", Scope: "a b"})
+
+	coreStorage, err := auth.NewFileTokenStorage(dir)
+	if err != nil {
+		t.Fatalf("NewFileTokenStorage() error = %v", err)
+	}
+	ctx := context.Background()
+	coreStorage.StoreToken(ctx, "search", auth.TokenInfo{AccessToken: "s-access", Scopes: []string{"x", "y"}, ResourceID: "search.api.globus.org"})
+
+	key := mustPassphraseKey(t, "migrate me")
+	migrated, err := MigratePlaintextStorage(dir, key)
+	if err != nil {
+		t.Fatalf("MigratePlaintextStorage() error = %v", err)
+	}
+	if migrated != 2 {
+		t.Errorf("MigratePlaintextStorage() migrated %d files, want 2", migrated)
+	}
+
+	files, _ := os.ReadDir(dir)
+	for _, file := range files {
+		if filepath.Ext(file.Name()) != encryptedFileExt {
+			t.Errorf("Plaintext file %s was not removed", file.Name())
+		}
+	}
+
+	storage, _ := NewEncryptedFileStorage(dir, key)
+	entry, err := storage.Lookup("transfer")
+	if err != nil || entry == nil || entry.RefreshToken != "t-refresh" || entry.Scope != "a b" {
+		t.Errorf("Migrated FileStorage entry = %+v, %v", entry, err)
+	}
+	info, err := storage.GetToken(ctx, "search")
+	if err != nil || info.AccessToken != "s-access" || len(info.Scopes) != 2 || info.ResourceID != "search.api.globus.org" {
+		t.Errorf("Migrated auth token = %+v, %v", info, err)
+	}
+
+	// Running the migration again is a no-op
+	if migrated, err := MigratePlaintextStorage(dir, key); err != nil || migrated != 0 {
+		t.Errorf("Second migration = %d, %v, want 0, nil", migrated, err)
+	}
+}
@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultNamespace is the namespace the Python SDK uses when none is given
+const DefaultNamespace = "DEFAULT"
+
+// JSONFormatVersion is the token file format version written by JSONTokenStorage
+const JSONFormatVersion = "2.0"
+
+// jsonSDKVersion is recorded in files written by this SDK
+const jsonSDKVersion = "globus-go-sdk"
+
+// pythonTokenData is a token record in the Python SDK's storage formats
+type pythonTokenData struct {
+	ResourceServer   string                 `json:"resource_server"`
+	IdentityID       *string                `json:"identity_id"`
+	Scope            string                 `json:"scope"`
+	AccessToken      string                 `json:"access_token"`
+	RefreshToken     *string                `json:"refresh_token"`
+	ExpiresAtSeconds int64                  `json:"expires_at_seconds"`
+	TokenType        *string                `json:"token_type"`
+	Extra            map[string]interface{} `json:"extra,omitempty"`
+}
+
+// pythonTokenFile is the top-level structure of a Python SDK token file.
+// Version 2.0 groups tokens by namespace; version 1.0 files only have by_rs.
+type pythonTokenFile struct {
+	SDKVersion    string                                `json:"globus-sdk.version"`
+	FormatVersion string                                `json:"format_version"`
+	Data          map[string]map[string]pythonTokenData `json:"data,omitempty"`
+	ByRS          map[string]pythonTokenData            `json:"by_rs,omitempty"`
+}
+
+// toEntry converts a Python token record to an Entry
+func (d pythonTokenData) toEntry(resource string) *Entry {
+	entry := &Entry{
+		Resource:    resource,
+		AccessToken: d.AccessToken,
+		ExpiresAt:   time.Unix(d.ExpiresAtSeconds, 0),
+		Scope:       d.Scope,
+	}
+	if d.RefreshToken != nil {
+		entry.RefreshToken = *d.RefreshToken
+	}
+	entry.TokenSet = &TokenSet{
+		AccessToken:  entry.AccessToken,
+		RefreshToken: entry.RefreshToken,
+		ExpiresAt:    entry.ExpiresAt,
+		Scope:        entry.Scope,
+		ResourceID:   entry.Resource,
+	}
+	return entry
+}
+
+// fromEntry updates a Python token record from an Entry, keeping the fields
+// this SDK does not track (identity, token type and extra data)
+func (d pythonTokenData) fromEntry(entry *Entry) pythonTokenData {
+	accessToken, refreshToken, expiresAt, scope := entry.AccessToken, entry.RefreshToken, entry.ExpiresAt, entry.Scope
+	if entry.TokenSet != nil {
+		accessToken = entry.TokenSet.AccessToken
+		refreshToken = entry.TokenSet.RefreshToken
+		expiresAt = entry.TokenSet.ExpiresAt
+		scope = entry.TokenSet.Scope
+	}
+
+	d.ResourceServer = entry.Resource
+	d.AccessToken = accessToken
+	d.ExpiresAtSeconds = expiresAt.Unix()
+	d.Scope = scope
+	d.RefreshToken = nil
+	if refreshToken != "" {
+		d.RefreshToken = &refreshToken
+	}
+	if d.TokenType == nil {
+		bearer := "Bearer"
+		d.TokenType = &bearer
+	}
+	return d
+}
+
+// JSONTokenStorage reads and writes the token file format used by the Python
+// SDK's JSONTokenStorage, so Python and Go tools can share one login. The
+// storage itself uses DefaultNamespace; use Namespace for other namespaces.
+//
+// The file is re-read on every operation so that changes made by other
+// processes are picked up, and is replaced atomically on every write.
+type JSONTokenStorage struct {
+	path  string
+	mutex sync.RWMutex
+}
+
+// NewJSONTokenStorage creates a storage backed by the token file at path.
+// The file is created on the first write if it does not exist.
+func NewJSONTokenStorage(path string) (*JSONTokenStorage, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+	return &JSONTokenStorage{path: path}, nil
+}
+
+// Namespace returns a view of the file limited to one namespace. Views share
+// the file and its lock with the storage they were created from.
+func (s *JSONTokenStorage) Namespace(namespace string) Storage {
+	return &jsonNamespaceStorage{file: s, namespace: namespace}
+}
+
+// Namespaces returns the namespaces present in the file
+func (s *JSONTokenStorage) Namespaces() ([]string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	file, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	namespaces := make([]string, 0, len(file.Data))
+	for namespace := range file.Data {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces, nil
+}
+
+// Store implements Storage.Store in DefaultNamespace
+func (s *JSONTokenStorage) Store(entry *Entry) error {
+	return s.Namespace(DefaultNamespace).Store(entry)
+}
+
+// Lookup implements Storage.Lookup in DefaultNamespace
+func (s *JSONTokenStorage) Lookup(resource string) (*Entry, error) {
+	return s.Namespace(DefaultNamespace).Lookup(resource)
+}
+
+// Delete implements Storage.Delete in DefaultNamespace
+func (s *JSONTokenStorage) Delete(resource string) error {
+	return s.Namespace(DefaultNamespace).Delete(resource)
+}
+
+// List implements Storage.List in DefaultNamespace
+func (s *JSONTokenStorage) List() ([]string, error) {
+	return s.Namespace(DefaultNamespace).List()
+}
+
+// load reads the token file, converting version 1.0 files to the 2.0 layout
+func (s *JSONTokenStorage) load() (*pythonTokenFile, error) {
+	file := &pythonTokenFile{}
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			file.Data = make(map[string]map[string]pythonTokenData)
+			return file, nil
+		}
+		return nil, fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("failed to parse token file %s: %w", s.path, err)
+	}
+
+	switch file.FormatVersion {
+	case JSONFormatVersion:
+	case "1.0":
+		file.Data = map[string]map[string]pythonTokenData{DefaultNamespace: file.ByRS}
+		file.ByRS = nil
+	default:
+		return nil, fmt.Errorf("unsupported token file format version %q in %s", file.FormatVersion, s.path)
+	}
+	if file.Data == nil {
+		file.Data = make(map[string]map[string]pythonTokenData)
+	}
+	return file, nil
+}
+
+// save writes the token file in the 2.0 format
+func (s *JSONTokenStorage) save(file *pythonTokenFile) error {
+	file.FormatVersion = JSONFormatVersion
+	file.SDKVersion = jsonSDKVersion
+	file.ByRS = nil
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal token file: %w", err)
+	}
+	return writeFileAtomic(s.path, data)
+}
+
+// jsonNamespaceStorage is one namespace of a JSONTokenStorage file
+type jsonNamespaceStorage struct {
+	file      *JSONTokenStorage
+	namespace string
+}
+
+// Store implements Storage.Store
+func (n *jsonNamespaceStorage) Store(entry *Entry) error {
+	if entry == nil || entry.Resource == "" {
+		return errors.New("invalid token entry")
+	}
+
+	n.file.mutex.Lock()
+	defer n.file.mutex.Unlock()
+
+	file, err := n.file.load()
+	if err != nil {
+		return err
+	}
+	tokens := file.Data[n.namespace]
+	if tokens == nil {
+		tokens = make(map[string]pythonTokenData)
+		file.Data[n.namespace] = tokens
+	}
+	tokens[entry.Resource] = tokens[entry.Resource].fromEntry(entry)
+
+	return n.file.save(file)
+}
+
+// Lookup implements Storage.Lookup. It returns nil, nil if the resource has
+// no stored token.
+func (n *jsonNamespaceStorage) Lookup(resource string) (*Entry, error) {
+	n.file.mutex.RLock()
+	defer n.file.mutex.RUnlock()
+
+	file, err := n.file.load()
+	if err != nil {
+		return nil, err
+	}
+	data, ok := file.Data[n.namespace][resource]
+	if !ok {
+		return nil, nil // Not found, but not an error
+	}
+	return data.toEntry(resource), nil
+}
+
+// Delete implements Storage.Delete
+func (n *jsonNamespaceStorage) Delete(resource string) error {
+	n.file.mutex.Lock()
+	defer n.file.mutex.Unlock()
+
+	file, err := n.file.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := file.Data[n.namespace][resource]; !ok {
+		return nil
+	}
+	delete(file.Data[n.namespace], resource)
+	if len(file.Data[n.namespace]) == 0 {
+		delete(file.Data, n.namespace)
+	}
+	return n.file.save(file)
+}
+
+// List implements Storage.List
+func (n *jsonNamespaceStorage) List() ([]string, error) {
+	n.file.mutex.RLock()
+	defer n.file.mutex.RUnlock()
+
+	file, err := n.file.load()
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]string, 0, len(file.Data[n.namespace]))
+	for resource := range file.Data[n.namespace] {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	return resources, nil
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// pythonTokenFileFixture is a token file as written by the Python SDK's
+// JSONTokenStorage
+const pythonTokenFileFixture = `{
+  "globus-sdk.version": "3.50.0",
+  "format_version": "2.0",
+  "data": {
+    "DEFAULT": {
+      "transfer.api.globus.org": {
+        "resource_server": "transfer.api.globus.org",
+        "identity_id": "ae341a98-d274-11e5-b888-dbae3a8ba545",
+        "scope": "urn:globus:auth:scope:transfer.api.globus.org:all",
+        "access_token": "py-transfer-access",
+        "refresh_token": "py-transfer-refresh",
+        "expires_at_seconds": 1893456000,
+        "token_type": "Bearer",
+        "extra": {"custom": "value"}
+      }
+    },
+    "userapp-other": {
+      "groups.api.globus.org": {
+        "resource_server": "groups.api.globus.org",
+        "identity_id": null,
+        "scope": "urn:globus:auth:scope:groups.api.globus.org:all",
+        "access_token": "py-groups-access",
+        "refresh_token": null,
+        "expires_at_seconds": 1893456000,
+        "token_type": "Bearer"
+      }
+    }
+  }
+}`
+
+func TestJSONTokenStorageReadsPythonFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.json")
+	if err := os.WriteFile(path, []byte(pythonTokenFileFixture), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	storage, err := NewJSONTokenStorage(path)
+	if err != nil {
+		t.Fatalf("NewJSONTokenStorage() error = %v", err)
+	}
+
+	entry, err := storage.Lookup("transfer.api.globus.org")
+	if err != nil || entry == nil {
+		t.Fatalf("Lookup() = %v, %v", entry, err)
+	}
+	if entry.AccessToken != "py-transfer-access" || entry.RefreshToken != "py-transfer-refresh" {
+		t.Errorf("Lookup() tokens = %q/%q", entry.AccessToken, entry.RefreshToken)
+	}
+	if !entry.ExpiresAt.Equal(time.Unix(1893456000, 0)) {
+		t.Errorf("Lookup() ExpiresAt = %v", entry.ExpiresAt)
+	}
+
+	// Other namespaces are isolated
+	if entry, _ := storage.Lookup("groups.api.globus.org"); entry != nil {
+		t.Error("Lookup() found a token from another namespace")
+	}
+	other := storage.Namespace("userapp-other")
+	if entry, err := other.Lookup("groups.api.globus.org"); err != nil || entry == nil || entry.RefreshToken != "" {
+		t.Errorf("Namespace Lookup() = %+v, %v", entry, err)
+	}
+
+	namespaces, err := storage.Namespaces()
+	if err != nil || len(namespaces) != 2 || namespaces[0] != DefaultNamespace {
+		t.Errorf("Namespaces() = %v, %v", namespaces, err)
+	}
+}
+
+func TestJSONTokenStorageWritesPythonFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.json")
+	if err := os.WriteFile(path, []byte(pythonTokenFileFixture), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	storage, _ := NewJSONTokenStorage(path)
+
+	// Refreshing a token keeps the fields only Python tracks
+	if err := storage.Store(&Entry{
+		Resource:     "transfer.api.globus.org",
+		AccessToken:  "go-transfer-access",
+		RefreshToken: "go-transfer-refresh",
+		ExpiresAt:    time.Unix(1900000000, 0),
+		Scope:        "urn:globus:auth:scope:transfer.api.globus.org:all",
+	}); err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+	if err := storage.Namespace("go-tools").Store(&Entry{Resource: "search.api.globus.org", AccessToken: "s"}); err != nil {
+		t.Fatalf("Namespace Store() error = %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	var file map[string]interface{}
+	if err := json.Unmarshal(data, &file); err != nil {
+		t.Fatalf("Written file is not JSON: %v", err)
+	}
+	if file["format_version"] != JSONFormatVersion {
+		t.Errorf("format_version = %v, want %s", file["format_version"], JSONFormatVersion)
+	}
+
+	record := file["data"].(map[string]interface{})["DEFAULT"].(map[string]interface{})["transfer.api.globus.org"].(map[string]interface{})
+	if record["access_token"] != "go-transfer-access" || record["expires_at_seconds"] != float64(1900000000) {
+		t.Errorf("Written record = %v", record)
+	}
+	if record["identity_id"] != "ae341a98-d274-11e5-b888-dbae3a8ba545" || record["token_type"] != "Bearer" {
+		t.Errorf("Written record lost Python fields: %v", record)
+	}
+	if extra, ok := record["extra"].(map[string]interface{}); !ok || extra["custom"] != "value" {
+		t.Errorf("Written record lost extra data: %v", record)
+	}
+
+	if err := storage.Namespace("userapp-other").Delete("groups.api.globus.org"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	namespaces, _ := storage.Namespaces()
+	if len(namespaces) != 2 || namespaces[1] != "go-tools" {
+		t.Errorf("Namespaces() after delete = %v, want [DEFAULT go-tools]", namespaces)
+	}
+}
+
+func TestJSONTokenStorageReadsVersion1(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.json")
+	legacy := `{"globus-sdk.version": "3.0.0", "format_version": "1.0", "by_rs": {
+		"auth.globus.org": {"resource_server": "auth.globus.org", "scope": "openid", "access_token": "old",
+		"refresh_token": "old-refresh", "expires_at_seconds": 1893456000, "token_type": "Bearer"}}}`
+	os.WriteFile(path, []byte(legacy), 0600)
+
+	storage, _ := NewJSONTokenStorage(path)
+	resources, err := storage.List()
+	if err != nil || len(resources) != 1 || resources[0] != "auth.globus.org" {
+		t.Fatalf("List() = %v, %v", resources, err)
+	}
+
+	// Writing upgrades the file to the current format
+	storage.Store(&Entry{Resource: "groups.api.globus.org", AccessToken: "g"})
+	data, _ := os.ReadFile(path)
+	var file pythonTokenFile
+	json.Unmarshal(data, &file)
+	if file.FormatVersion != JSONFormatVersion || len(file.Data[DefaultNamespace]) != 2 || file.ByRS != nil {
+		t.Errorf("Upgraded file = %+v", file)
+	}
+}
+
+func TestWithNamespace(t *testing.T) {
+	base := NewMemoryStorage()
+	alice := WithNamespace(base, "alice")
+	bob := WithNamespace(base, "bob")
+
+	alice.Store(&Entry{Resource: "transfer.api.globus.org", AccessToken: "alice-token"})
+	bob.Store(&Entry{Resource: "transfer.api.globus.org", AccessToken: "bob-token"})
+
+	entry, err := alice.Lookup("transfer.api.globus.org")
+	if err != nil || entry == nil || entry.AccessToken != "alice-token" || entry.Resource != "transfer.api.globus.org" {
+		t.Errorf("alice Lookup() = %+v, %v", entry, err)
+	}
+	if resources, _ := bob.List(); len(resources) != 1 || resources[0] != "transfer.api.globus.org" {
+		t.Errorf("bob List() = %v", resources)
+	}
+	if keys, _ := base.List(); len(keys) != 2 {
+		t.Errorf("base List() = %v, want 2 prefixed keys", keys)
+	}
+
+	// Storages with native namespaces are used directly
+	jsonStorage, _ := NewJSONTokenStorage(filepath.Join(t.TempDir(), "tokens.json"))
+	WithNamespace(jsonStorage, "alice").Store(&Entry{Resource: "auth.globus.org", AccessToken: "a"})
+	if namespaces, _ := jsonStorage.Namespaces(); len(namespaces) != 1 || namespaces[0] != "alice" {
+		t.Errorf("JSON Namespaces() = %v, want [alice]", namespaces)
+	}
+}
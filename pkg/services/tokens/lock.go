@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// lockDirName is the subdirectory of a storage directory holding lock files
+const lockDirName = ".locks"
+
+// Locker is implemented by storages that can hold a lock on a resource
+// across processes. Lock blocks until the lock is held and returns the
+// function that releases it.
+type Locker interface {
+	Lock(resource string) (unlock func(), err error)
+}
+
+// Lock implements Locker with an advisory lock file in the storage directory
+func (s *FileStorage) Lock(resource string) (func(), error) {
+	return lockFile(s.directory, resource)
+}
+
+// Lock implements Locker with an advisory lock file in the storage directory
+func (s *EncryptedFileStorage) Lock(resource string) (func(), error) {
+	return lockFile(s.directory, resource)
+}
+
+// lockFile takes an exclusive advisory lock on the lock file for resource in
+// directory. Lock files are never removed, since removing a file another
+// process is waiting on would let two processes hold the lock at once.
+func lockFile(directory, resource string) (func(), error) {
+	dir := filepath.Join(directory, lockDirName)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create lock directory: %w", err)
+	}
+
+	name := base64.RawURLEncoding.EncodeToString([]byte(resource)) + ".lock"
+	file, err := os.OpenFile(filepath.Join(dir, name), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock file: %w", err)
+	}
+	if err := lockFileHandle(file); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to lock %s: %w", resource, err)
+	}
+
+	return func() {
+		_ = unlockFileHandle(file)
+		file.Close()
+	}, nil
+}
+
+// Ensure the file storages implement Locker
+var (
+	_ Locker = (*FileStorage)(nil)
+	_ Locker = (*EncryptedFileStorage)(nil)
+)
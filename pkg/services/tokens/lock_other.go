@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+
+//go:build !unix && !windows
+
+package tokens
+
+import "os"
+
+// lockFileHandle is a no-op on platforms without file locking; tokens are
+// then only shared safely within one process
+func lockFileHandle(file *os.File) error {
+	return nil
+}
+
+// unlockFileHandle is a no-op on platforms without file locking
+func unlockFileHandle(file *os.File) error {
+	return nil
+}
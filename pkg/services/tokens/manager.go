@@ -4,7 +4,9 @@ package tokens
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -19,12 +21,61 @@ type RefreshHandler interface {
 // Ensure that auth.Client implements RefreshHandler
 var _ RefreshHandler = (*auth.Client)(nil)
 
-// Manager handles token storage, retrieval, and automatic refreshing
+// TokenRevoker defines the interface for token revocation
+type TokenRevoker interface {
+	RevokeToken(ctx context.Context, token string) error
+}
+
+// Ensure that auth.Client implements TokenRevoker
+var _ TokenRevoker = (*auth.Client)(nil)
+
+// DefaultRefreshConcurrency is the number of tokens refreshed in parallel by
+// background refresh when no concurrency is configured
+const DefaultRefreshConcurrency = 4
+
+// userNamespacePrefix prefixes identity IDs to form per-user namespaces
+const userNamespacePrefix = "user-"
+
+// ErrNoRevoker is returned when revoking tokens without a TokenRevoker
+var ErrNoRevoker = errors.New("no token revoker configured")
+
+// Manager handles token storage, retrieval, and automatic refreshing.
+//
+// A Manager created by NewManager works on the whole storage. ForUser and
+// ForNamespace return managers limited to one namespace, which share the
+// configuration and refresh locks of the manager they were created from.
 type Manager struct {
-	Storage          Storage
-	RefreshThreshold time.Duration
-	RefreshHandler   RefreshHandler
-	refreshMutex     sync.Mutex
+	Storage            Storage
+	RefreshThreshold   time.Duration
+	RefreshHandler     RefreshHandler
+	Revoker            TokenRevoker
+	RefreshConcurrency int
+
+	namespace string
+	root      Storage
+	locks     *refreshLocks
+	locksOnce sync.Once
+}
+
+// refreshLocks holds one lock per namespaced resource so that a token is
+// never refreshed twice at once, while different tokens refresh in parallel
+type refreshLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock acquires the lock for key and returns the function that releases it
+func (l *refreshLocks) lock(key string) func() {
+	l.mu.Lock()
+	lock, ok := l.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		l.locks[key] = lock
+	}
+	l.mu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
 }
 
 // NewManager creates a new token manager with the provided options
@@ -43,12 +94,156 @@ func NewManager(opts ...ClientOption) (*Manager, error) {
 	}
 
 	return &Manager{
-		Storage:          options.storage,
-		RefreshThreshold: options.refreshThreshold,
-		RefreshHandler:   options.refreshHandler,
+		Storage:            options.storage,
+		RefreshThreshold:   options.refreshThreshold,
+		RefreshHandler:     options.refreshHandler,
+		Revoker:            options.revoker,
+		RefreshConcurrency: options.refreshConcurrency,
 	}, nil
 }
 
+// UserNamespace returns the namespace used by ForUser for an identity
+func UserNamespace(identityID string) string {
+	return userNamespacePrefix + identityID
+}
+
+// ForUser returns a manager whose tokens are isolated to one user, keyed by
+// the user's Globus Auth identity ID
+func (m *Manager) ForUser(identityID string) *Manager {
+	return m.ForNamespace(UserNamespace(identityID))
+}
+
+// ForNamespace returns a manager whose tokens are isolated to one namespace
+func (m *Manager) ForNamespace(namespace string) *Manager {
+	root := m.rootStorage()
+	return &Manager{
+		Storage:            WithNamespace(root, namespace),
+		RefreshThreshold:   m.RefreshThreshold,
+		RefreshHandler:     m.RefreshHandler,
+		Revoker:            m.Revoker,
+		RefreshConcurrency: m.RefreshConcurrency,
+		namespace:          namespace,
+		root:               root,
+		locks:              m.refreshLocks(),
+	}
+}
+
+// Namespace returns the manager's namespace, or "" for a manager that works
+// on the whole storage
+func (m *Manager) Namespace() string {
+	return m.namespace
+}
+
+// Namespaces returns the namespaces that currently hold tokens
+func (m *Manager) Namespaces() ([]string, error) {
+	return ListNamespaces(m.rootStorage())
+}
+
+// Users returns the identity IDs of the users that currently hold tokens
+func (m *Manager) Users() ([]string, error) {
+	namespaces, err := m.Namespaces()
+	if err != nil {
+		return nil, err
+	}
+	var users []string
+	for _, namespace := range namespaces {
+		if strings.HasPrefix(namespace, userNamespacePrefix) {
+			users = append(users, strings.TrimPrefix(namespace, userNamespacePrefix))
+		}
+	}
+	return users, nil
+}
+
+// List returns the resources with stored tokens in the manager's namespace.
+// A manager without a namespace does not list namespaced entries.
+func (m *Manager) List() ([]string, error) {
+	resources, err := m.Storage.List()
+	if err != nil {
+		return nil, err
+	}
+	if m.namespace != "" {
+		return resources, nil
+	}
+	return withoutNamespacedKeys(m.Storage, resources), nil
+}
+
+// RevokeAll revokes every token in the manager's namespace with Globus Auth
+// and removes it from storage. Tokens that fail to revoke are kept so the
+// call can be retried; all failures are returned together.
+func (m *Manager) RevokeAll(ctx context.Context) error {
+	if m.Revoker == nil {
+		return ErrNoRevoker
+	}
+
+	resources, err := m.List()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, resource := range resources {
+		if err := m.revoke(ctx, resource); err != nil {
+			errs = append(errs, fmt.Errorf("failed to revoke tokens for %s: %w", resource, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// RevokeUser revokes and removes every token stored for a user
+func (m *Manager) RevokeUser(ctx context.Context, identityID string) error {
+	return m.ForUser(identityID).RevokeAll(ctx)
+}
+
+// revoke revokes the refresh and access tokens of one resource and deletes it
+func (m *Manager) revoke(ctx context.Context, resource string) error {
+	unlock := m.refreshLocks().lock(m.lockKey(resource))
+	defer unlock()
+
+	entry, err := m.Storage.Lookup(resource)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return nil
+	}
+
+	// Revoking the refresh token also invalidates the access tokens issued
+	// from it, but static access tokens still need revoking on their own
+	for _, token := range []string{entry.RefreshToken, entry.AccessToken} {
+		if token == "" {
+			continue
+		}
+		if err := m.Revoker.RevokeToken(ctx, token); err != nil {
+			return err
+		}
+	}
+
+	return m.Storage.Delete(resource)
+}
+
+// rootStorage returns the storage before namespacing
+func (m *Manager) rootStorage() Storage {
+	if m.root != nil {
+		return m.root
+	}
+	return m.Storage
+}
+
+// refreshLocks returns the lock table shared with related managers
+func (m *Manager) refreshLocks() *refreshLocks {
+	m.locksOnce.Do(func() {
+		if m.locks == nil {
+			m.locks = &refreshLocks{locks: make(map[string]*sync.Mutex)}
+		}
+	})
+	return m.locks
+}
+
+// lockKey identifies a resource across namespaces
+func (m *Manager) lockKey(resource string) string {
+	return m.namespace + namespaceSeparator + resource
+}
+
 // GetToken retrieves a token, automatically refreshing it if needed
 func (m *Manager) GetToken(ctx context.Context, resource string) (*Entry, error) {
 	// Get the token from storage
@@ -102,9 +297,9 @@ func (m *Manager) StoreToken(ctx context.Context, entry *Entry) error {
 
 // refreshToken refreshes a token and stores it
 func (m *Manager) refreshToken(ctx context.Context, resource string, entry *Entry) (*Entry, error) {
-	// Use a mutex to prevent multiple simultaneous refreshes for the same token
-	m.refreshMutex.Lock()
-	defer m.refreshMutex.Unlock()
+	// Use a lock to prevent multiple simultaneous refreshes for the same token
+	unlock := m.refreshLocks().lock(m.lockKey(resource))
+	defer unlock()
 
 	// Check if another goroutine already refreshed the token while we were waiting
 	latestEntry, err := m.Storage.Lookup(resource)
@@ -114,6 +309,10 @@ func (m *Manager) refreshToken(ctx context.Context, resource string, entry *Entr
 		return latestEntry, nil
 	}
 
+	if m.RefreshHandler == nil {
+		return nil, errors.New("no refresh handler configured")
+	}
+
 	// Refresh the token
 	tokenResponse, err := m.RefreshHandler.RefreshToken(ctx, entry.TokenSet.RefreshToken)
 	if err != nil {
@@ -184,28 +383,71 @@ func (m *Manager) StartBackgroundRefresh(refreshInterval time.Duration) func() {
 	return cancel
 }
 
-// refreshAllTokens refreshes all tokens that are close to expiry
+// refreshAllTokens refreshes all tokens that are close to expiry. A manager
+// without a namespace also walks every namespace in the storage. At most
+// RefreshConcurrency tokens are refreshed at once.
 func (m *Manager) refreshAllTokens(ctx context.Context) {
-	// List all tokens
-	resources, err := m.Storage.List()
-	if err != nil {
-		return
+	managers := []*Manager{m}
+	if m.namespace == "" {
+		if namespaces, err := m.Namespaces(); err == nil {
+			for _, namespace := range namespaces {
+				// Storages with native namespaces already list the default
+				// namespace as the manager's own entries
+				if _, native := m.Storage.(NamespaceProvider); native && namespace == DefaultNamespace {
+					continue
+				}
+				managers = append(managers, m.ForNamespace(namespace))
+			}
+		}
 	}
 
-	// Refresh each token that needs it
-	for _, resource := range resources {
-		// Use a timeout for each token refresh
-		refreshCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-
-		// Get the token
-		entry, err := m.Storage.Lookup(resource)
-		if err == nil && entry != nil && entry.TokenSet != nil && entry.TokenSet.CanRefresh() {
-			// If the token is close to expiry, refresh it
-			if entry.TokenSet.IsExpired() || time.Until(entry.TokenSet.ExpiresAt) < m.RefreshThreshold {
-				_, _ = m.refreshToken(refreshCtx, resource, entry) // Ignore errors
+	concurrency := m.RefreshConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultRefreshConcurrency
+	}
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for _, manager := range managers {
+		// List all tokens
+		resources, err := manager.List()
+		if err != nil {
+			continue
+		}
+
+		// Refresh each token that needs it
+		for _, resource := range resources {
+			select {
+			case <-ctx.Done():
+				wg.Wait()
+				return
+			case semaphore <- struct{}{}:
 			}
+
+			wg.Add(1)
+			go func(manager *Manager, resource string) {
+				defer wg.Done()
+				defer func() { <-semaphore }()
+				manager.refreshIfNeeded(ctx, resource)
+			}(manager, resource)
 		}
+	}
 
-		cancel()
+	wg.Wait()
+}
+
+// refreshIfNeeded refreshes one token if it is close to expiry
+func (m *Manager) refreshIfNeeded(ctx context.Context, resource string) {
+	// Use a timeout for each token refresh
+	refreshCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// Get the token
+	entry, err := m.Storage.Lookup(resource)
+	if err == nil && entry != nil && entry.TokenSet != nil && entry.TokenSet.CanRefresh() {
+		// If the token is close to expiry, refresh it
+		if entry.TokenSet.IsExpired() || time.Until(entry.TokenSet.ExpiresAt) < m.RefreshThreshold {
+			_, _ = m.refreshToken(refreshCtx, resource, entry) // Ignore errors
+		}
 	}
 }
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"sort"
+	"strings"
+)
+
+// namespaceSeparator separates the namespace from the resource in keys of
+// storages without native namespace support. A double colon is used so that
+// resources named by URL are not mistaken for namespaced keys.
+const namespaceSeparator = "::"
+
+// NamespaceProvider is implemented by storages with native namespace support,
+// such as JSONTokenStorage and SQLTokenStorage
+type NamespaceProvider interface {
+	// Namespace returns a view of the storage limited to one namespace
+	Namespace(namespace string) Storage
+}
+
+// NamespaceLister is implemented by storages that can enumerate their namespaces
+type NamespaceLister interface {
+	// Namespaces returns the namespaces that hold tokens
+	Namespaces() ([]string, error)
+}
+
+// ListNamespaces returns the namespaces that hold tokens in storage. For
+// storages without native namespaces, they are found from prefixed keys.
+func ListNamespaces(storage Storage) ([]string, error) {
+	if lister, ok := storage.(NamespaceLister); ok {
+		return lister.Namespaces()
+	}
+
+	keys, err := storage.List()
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var namespaces []string
+	for _, key := range keys {
+		if namespace, _, ok := strings.Cut(key, namespaceSeparator); ok && !seen[namespace] {
+			seen[namespace] = true
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces, nil
+}
+
+// withoutNamespacedKeys removes prefixed namespace keys from a storage listing
+func withoutNamespacedKeys(storage Storage, keys []string) []string {
+	if _, ok := storage.(NamespaceProvider); ok {
+		return keys
+	}
+	filtered := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if !strings.Contains(key, namespaceSeparator) {
+			filtered = append(filtered, key)
+		}
+	}
+	return filtered
+}
+
+// WithNamespace returns a view of storage limited to one namespace. Storages
+// that implement NamespaceProvider are asked for their native view; for other
+// storages, resources are stored under "<namespace>::<resource>" keys.
+func WithNamespace(storage Storage, namespace string) Storage {
+	if provider, ok := storage.(NamespaceProvider); ok {
+		return provider.Namespace(namespace)
+	}
+	return &prefixedStorage{storage: storage, prefix: namespace + namespaceSeparator}
+}
+
+// prefixedStorage emulates a namespace by prefixing resource names
+type prefixedStorage struct {
+	storage Storage
+	prefix  string
+}
+
+// Store implements Storage.Store
+func (p *prefixedStorage) Store(entry *Entry) error {
+	if entry == nil {
+		return p.storage.Store(nil)
+	}
+	prefixed := *entry
+	prefixed.Resource = p.prefix + entry.Resource
+	if entry.TokenSet != nil {
+		tokenSet := *entry.TokenSet
+		tokenSet.ResourceID = prefixed.Resource
+		prefixed.TokenSet = &tokenSet
+	}
+	return p.storage.Store(&prefixed)
+}
+
+// Lookup implements Storage.Lookup
+func (p *prefixedStorage) Lookup(resource string) (*Entry, error) {
+	entry, err := p.storage.Lookup(p.prefix + resource)
+	if err != nil || entry == nil {
+		return entry, err
+	}
+	entry.Resource = resource
+	if entry.TokenSet != nil {
+		entry.TokenSet.ResourceID = resource
+	}
+	return entry, nil
+}
+
+// Delete implements Storage.Delete
+func (p *prefixedStorage) Delete(resource string) error {
+	return p.storage.Delete(p.prefix + resource)
+}
+
+// List implements Storage.List
+func (p *prefixedStorage) List() ([]string, error) {
+	all, err := p.storage.List()
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]string, 0, len(all))
+	for _, key := range all {
+		if strings.HasPrefix(key, p.prefix) {
+			resources = append(resources, strings.TrimPrefix(key, p.prefix))
+		}
+	}
+	return resources, nil
+}
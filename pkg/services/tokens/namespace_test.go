@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/services/auth"
+)
+
+// mockRevoker records revoked tokens and fails for tokens in failFor
+type mockRevoker struct {
+	mu      sync.Mutex
+	revoked []string
+	failFor map[string]bool
+}
+
+func (r *mockRevoker) RevokeToken(ctx context.Context, token string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failFor[token] {
+		return errors.New("revocation failed")
+	}
+	r.revoked = append(r.revoked, token)
+	return nil
+}
+
+func TestManagerForUserIsolation(t *testing.T) {
+	storage := NewMemoryStorage()
+	manager, err := NewManager(WithStorage(storage))
+	if err != nil {
+		t.Fatalf("NewManager() error = %v", err)
+	}
+	ctx := context.Background()
+
+	alice := manager.ForUser("alice-id")
+	bob := manager.ForUser("bob-id")
+	expires := time.Now().Add(time.Hour)
+
+	alice.StoreToken(ctx, &Entry{Resource: "transfer.api.globus.org", AccessToken: "alice-transfer", ExpiresAt: expires})
+	bob.StoreToken(ctx, &Entry{Resource: "transfer.api.globus.org", AccessToken: "bob-transfer", ExpiresAt: expires})
+	manager.StoreToken(ctx, &Entry{Resource: "https://service.example.org", AccessToken: "app", ExpiresAt: expires})
+
+	entry, err := alice.GetToken(ctx, "transfer.api.globus.org")
+	if err != nil || entry.TokenSet.AccessToken != "alice-transfer" {
+		t.Errorf("alice GetToken() = %+v, %v", entry, err)
+	}
+	entry, err = bob.GetToken(ctx, "transfer.api.globus.org")
+	if err != nil || entry.TokenSet.AccessToken != "bob-transfer" {
+		t.Errorf("bob GetToken() = %+v, %v", entry, err)
+	}
+	if alice.Namespace() != UserNamespace("alice-id") {
+		t.Errorf("Namespace() = %q", alice.Namespace())
+	}
+
+	if resources, err := manager.List(); err != nil || len(resources) != 1 || resources[0] != "https://service.example.org" {
+		t.Errorf("root List() = %v, %v, want only the app token", resources, err)
+	}
+	if resources, err := alice.List(); err != nil || len(resources) != 1 {
+		t.Errorf("alice List() = %v, %v", resources, err)
+	}
+
+	users, err := manager.Users()
+	sort.Strings(users)
+	if err != nil || len(users) != 2 || users[0] != "alice-id" || users[1] != "bob-id" {
+		t.Errorf("Users() = %v, %v", users, err)
+	}
+}
+
+func TestManagerRevokeUser(t *testing.T) {
+	storage := NewMemoryStorage()
+	revoker := &mockRevoker{failFor: map[string]bool{"bob-bad-refresh": true}}
+	manager, _ := NewManager(WithStorage(storage), WithRevoker(revoker))
+	ctx := context.Background()
+
+	alice := manager.ForUser("alice-id")
+	alice.StoreToken(ctx, &Entry{Resource: "transfer.api.globus.org", AccessToken: "a1", RefreshToken: "r1"})
+	alice.StoreToken(ctx, &Entry{Resource: "groups.api.globus.org", AccessToken: "a2"})
+	bob := manager.ForUser("bob-id")
+	bob.StoreToken(ctx, &Entry{Resource: "transfer.api.globus.org", AccessToken: "b1", RefreshToken: "bob-bad-refresh"})
+
+	if err := manager.RevokeUser(ctx, "alice-id"); err != nil {
+		t.Fatalf("RevokeUser(alice) error = %v", err)
+	}
+	if len(revoker.revoked) != 3 {
+		t.Errorf("Revoked %v, want 3 tokens", revoker.revoked)
+	}
+	if resources, _ := alice.List(); len(resources) != 0 {
+		t.Errorf("alice List() after revoke = %v, want empty", resources)
+	}
+
+	// A failed revocation keeps the token for a retry
+	if err := manager.RevokeUser(ctx, "bob-id"); err == nil {
+		t.Error("RevokeUser(bob) error = nil, want revocation failure")
+	}
+	if resources, _ := bob.List(); len(resources) != 1 {
+		t.Errorf("bob List() after failed revoke = %v, want token kept", resources)
+	}
+
+	noRevoker, _ := NewManager(WithStorage(storage))
+	if err := noRevoker.RevokeUser(ctx, "bob-id"); !errors.Is(err, ErrNoRevoker) {
+		t.Errorf("RevokeUser() without revoker error = %v, want ErrNoRevoker", err)
+	}
+}
+
+// concurrencyTrackingHandler records the peak number of concurrent refreshes
+type concurrencyTrackingHandler struct {
+	active int32
+	peak   int32
+	calls  int32
+}
+
+func (h *concurrencyTrackingHandler) RefreshToken(ctx context.Context, refreshToken string) (*auth.TokenResponse, error) {
+	active := atomic.AddInt32(&h.active, 1)
+	defer atomic.AddInt32(&h.active, -1)
+	atomic.AddInt32(&h.calls, 1)
+	for {
+		peak := atomic.LoadInt32(&h.peak)
+		if active <= peak || atomic.CompareAndSwapInt32(&h.peak, peak, active) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	return &auth.TokenResponse{AccessToken: "refreshed-" + refreshToken, ExpiresIn: 3600}, nil
+}
+
+func TestRefreshAllTokensAcrossNamespaces(t *testing.T) {
+	storage := NewMemoryStorage()
+	handler := &concurrencyTrackingHandler{}
+	manager, _ := NewManager(
+		WithStorage(storage),
+		WithRefreshHandler(handler),
+		WithRefreshConcurrency(2),
+	)
+	ctx := context.Background()
+
+	expired := time.Now().Add(-time.Minute)
+	manager.StoreToken(ctx, &Entry{Resource: "app", AccessToken: "x", RefreshToken: "app", ExpiresAt: expired})
+	for _, user := range []string{"u1", "u2", "u3"} {
+		for _, resource := range []string{"transfer", "groups"} {
+			manager.ForUser(user).StoreToken(ctx, &Entry{
+				Resource: resource, AccessToken: "x", RefreshToken: user + "-" + resource, ExpiresAt: expired,
+			})
+		}
+	}
+
+	manager.refreshAllTokens(ctx)
+
+	if calls := atomic.LoadInt32(&handler.calls); calls != 7 {
+		t.Errorf("Refreshed %d tokens, want 7", calls)
+	}
+	if peak := atomic.LoadInt32(&handler.peak); peak > 2 {
+		t.Errorf("Peak concurrent refreshes = %d, want at most 2", peak)
+	}
+
+	entry, err := manager.ForUser("u2").GetToken(ctx, "groups")
+	if err != nil || entry.TokenSet.AccessToken != "refreshed-u2-groups" {
+		t.Errorf("u2 groups token = %+v, %v", entry, err)
+	}
+}
+
+func TestWithNamespaceFileStorage(t *testing.T) {
+	root := t.TempDir()
+	directory := filepath.Join(root, "tokens")
+	storage, err := NewFileStorage(directory)
+	if err != nil {
+		t.Fatalf("NewFileStorage() error = %v", err)
+	}
+
+	namespaces := []string{"user-ae341a98-d274-11e5-b888-dbae3a8ba545", "../escape", "a/b"}
+	for _, namespace := range namespaces {
+		view := WithNamespace(storage, namespace)
+		if err := view.Store(&Entry{Resource: "transfer.api.globus.org", AccessToken: namespace}); err != nil {
+			t.Fatalf("Store() in %q error = %v", namespace, err)
+		}
+		entry, err := view.Lookup("transfer.api.globus.org")
+		if err != nil || entry == nil || entry.AccessToken != namespace || entry.Resource != "transfer.api.globus.org" {
+			t.Errorf("Lookup() in %q = %+v, %v", namespace, entry, err)
+		}
+		if resources, err := view.List(); err != nil || len(resources) != 1 || resources[0] != "transfer.api.globus.org" {
+			t.Errorf("List() in %q = %v, %v", namespace, resources, err)
+		}
+	}
+
+	// Every entry is a plain file in the storage directory whose name is
+	// valid on Windows
+	files, err := os.ReadDir(directory)
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	if len(files) != len(namespaces) {
+		t.Errorf("Storage directory has %d entries, want %d", len(files), len(namespaces))
+	}
+	for _, file := range files {
+		if file.IsDir() || strings.ContainsAny(file.Name(), `<>:"/\|?*`) {
+			t.Errorf("Unsafe token file name %q", file.Name())
+		}
+	}
+	if outside, _ := os.ReadDir(root); len(outside) != 1 {
+		t.Errorf("Token files written outside the storage directory: %v", outside)
+	}
+
+	listed, err := ListNamespaces(storage)
+	if err != nil {
+		t.Fatalf("ListNamespaces() error = %v", err)
+	}
+	sort.Strings(namespaces)
+	if strings.Join(listed, ",") != strings.Join(namespaces, ",") {
+		t.Errorf("ListNamespaces() = %v, want %v", listed, namespaces)
+	}
+
+	// Plain resource names keep their existing file names
+	if err := storage.Store(&Entry{Resource: "auth.globus.org", AccessToken: "a"}); err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(directory, "auth.globus.org")); err != nil {
+		t.Errorf("Plain resource file: %v", err)
+	}
+}
@@ -15,16 +15,19 @@ type ClientOption func(*clientOptions)
 
 // clientOptions represents options for configuring a Token Manager
 type clientOptions struct {
-	storage          Storage
-	refreshHandler   RefreshHandler
-	refreshThreshold time.Duration
+	storage            Storage
+	refreshHandler     RefreshHandler
+	revoker            TokenRevoker
+	refreshThreshold   time.Duration
+	refreshConcurrency int
 }
 
 // defaultOptions returns the default client options
 func defaultOptions() *clientOptions {
 	return &clientOptions{
-		storage:          NewMemoryStorage(),
-		refreshThreshold: 5 * time.Minute,
+		storage:            NewMemoryStorage(),
+		refreshThreshold:   5 * time.Minute,
+		refreshConcurrency: DefaultRefreshConcurrency,
 	}
 }
 
@@ -42,10 +45,18 @@ func WithRefreshHandler(refreshHandler RefreshHandler) ClientOption {
 	}
 }
 
-// WithAuthClient sets an auth client as the refresh handler
+// WithRevoker sets the revoker used by RevokeAll and RevokeUser
+func WithRevoker(revoker TokenRevoker) ClientOption {
+	return func(o *clientOptions) {
+		o.revoker = revoker
+	}
+}
+
+// WithAuthClient sets an auth client as the refresh handler and revoker
 func WithAuthClient(authClient *auth.Client) ClientOption {
 	return func(o *clientOptions) {
 		o.refreshHandler = authClient
+		o.revoker = authClient
 	}
 }
 
@@ -56,6 +67,14 @@ func WithRefreshThreshold(threshold time.Duration) ClientOption {
 	}
 }
 
+// WithRefreshConcurrency sets how many tokens background refresh refreshes
+// in parallel
+func WithRefreshConcurrency(concurrency int) ClientOption {
+	return func(o *clientOptions) {
+		o.refreshConcurrency = concurrency
+	}
+}
+
 // WithFileStorage sets file-based storage with the specified directory
 func WithFileStorage(directory string) ClientOption {
 	return func(o *clientOptions) {
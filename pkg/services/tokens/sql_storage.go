@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// sqlSchema creates the tables used by the Python SDK's SQLiteTokenStorage
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS config_storage (
+		namespace VARCHAR NOT NULL,
+		config_name VARCHAR NOT NULL,
+		config_data_json VARCHAR NOT NULL,
+		PRIMARY KEY (namespace, config_name)
+	)`,
+	`CREATE TABLE IF NOT EXISTS token_storage (
+		namespace VARCHAR NOT NULL,
+		resource_server VARCHAR NOT NULL,
+		token_data_json VARCHAR NOT NULL,
+		PRIMARY KEY (namespace, resource_server)
+	)`,
+	`CREATE TABLE IF NOT EXISTS sdk_storage_adapter_internal (
+		attribute VARCHAR NOT NULL,
+		value VARCHAR NOT NULL,
+		PRIMARY KEY (attribute)
+	)`,
+}
+
+// SQLTokenStorage reads and writes tokens in the database layout used by the
+// Python SDK's SQLiteTokenStorage. The SDK does not bundle a database driver:
+// open the database with the SQLite driver of your choice and pass the
+// *sql.DB. The storage itself uses DefaultNamespace; use Namespace for other
+// namespaces.
+type SQLTokenStorage struct {
+	db        *sql.DB
+	namespace string
+}
+
+// NewSQLTokenStorage creates a storage on db, creating the tables if they do
+// not exist
+func NewSQLTokenStorage(db *sql.DB) (*SQLTokenStorage, error) {
+	if db == nil {
+		return nil, errors.New("database is required")
+	}
+	for _, statement := range sqlSchema {
+		if _, err := db.Exec(statement); err != nil {
+			return nil, fmt.Errorf("failed to create token tables: %w", err)
+		}
+	}
+	if _, err := db.Exec(
+		`INSERT OR IGNORE INTO sdk_storage_adapter_internal (attribute, value) VALUES (?, ?)`,
+		"globus-sdk.database_schema_version", "2",
+	); err != nil {
+		return nil, fmt.Errorf("failed to record schema version: %w", err)
+	}
+	return &SQLTokenStorage{db: db, namespace: DefaultNamespace}, nil
+}
+
+// Namespace returns a view of the database limited to one namespace
+func (s *SQLTokenStorage) Namespace(namespace string) Storage {
+	return &SQLTokenStorage{db: s.db, namespace: namespace}
+}
+
+// Namespaces returns the namespaces that have stored tokens
+func (s *SQLTokenStorage) Namespaces() ([]string, error) {
+	rows, err := s.db.Query(`SELECT DISTINCT namespace FROM token_storage ORDER BY namespace`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	return scanStrings(rows)
+}
+
+// Store implements Storage.Store
+func (s *SQLTokenStorage) Store(entry *Entry) error {
+	if entry == nil || entry.Resource == "" {
+		return errors.New("invalid token entry")
+	}
+
+	// Keep the fields the Python SDK tracks but this SDK does not
+	var existing pythonTokenData
+	if data, err := s.lookup(entry.Resource); err != nil {
+		return err
+	} else if data != nil {
+		existing = *data
+	}
+
+	payload, err := json.Marshal(existing.fromEntry(entry))
+	if err != nil {
+		return fmt.Errorf("failed to marshal token entry: %w", err)
+	}
+
+	if _, err := s.db.Exec(
+		`INSERT OR REPLACE INTO token_storage (namespace, resource_server, token_data_json) VALUES (?, ?, ?)`,
+		s.namespace, entry.Resource, string(payload),
+	); err != nil {
+		return fmt.Errorf("failed to store token: %w", err)
+	}
+	return nil
+}
+
+// Lookup implements Storage.Lookup. It returns nil, nil if the resource has
+// no stored token.
+func (s *SQLTokenStorage) Lookup(resource string) (*Entry, error) {
+	data, err := s.lookup(resource)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return data.toEntry(resource), nil
+}
+
+// Delete implements Storage.Delete
+func (s *SQLTokenStorage) Delete(resource string) error {
+	if _, err := s.db.Exec(
+		`DELETE FROM token_storage WHERE namespace = ? AND resource_server = ?`,
+		s.namespace, resource,
+	); err != nil {
+		return fmt.Errorf("failed to delete token: %w", err)
+	}
+	return nil
+}
+
+// List implements Storage.List
+func (s *SQLTokenStorage) List() ([]string, error) {
+	rows, err := s.db.Query(
+		`SELECT resource_server FROM token_storage WHERE namespace = ? ORDER BY resource_server`,
+		s.namespace,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tokens: %w", err)
+	}
+	return scanStrings(rows)
+}
+
+// lookup reads the stored record for a resource, or nil if there is none
+func (s *SQLTokenStorage) lookup(resource string) (*pythonTokenData, error) {
+	var payload string
+	err := s.db.QueryRow(
+		`SELECT token_data_json FROM token_storage WHERE namespace = ? AND resource_server = ?`,
+		s.namespace, resource,
+	).Scan(&payload)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token: %w", err)
+	}
+
+	var data pythonTokenData
+	if err := json.Unmarshal([]byte(payload), &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token entry: %w", err)
+	}
+	return &data, nil
+}
+
+// scanStrings reads a single string column from every row
+func scanStrings(rows *sql.Rows) ([]string, error) {
+	defer rows.Close()
+
+	var values []string
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, fmt.Errorf("failed to read row: %w", err)
+		}
+		values = append(values, value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read rows: %w", err)
+	}
+	return values, nil
+}
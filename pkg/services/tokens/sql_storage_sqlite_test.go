@@ -0,0 +1,87 @@
+//go:build sqlite
+// +build sqlite
+
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	_ "modernc.org/sqlite"
+)
+
+// TestSQLTokenStorageSQLite runs SQLTokenStorage against a real SQLite
+// database, so the schema and statements are executed rather than matched.
+// Run it with: go test -tags sqlite ./pkg/services/tokens/
+func TestSQLTokenStorageSQLite(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "storage.db"))
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	defer db.Close()
+
+	storage, err := NewSQLTokenStorage(db)
+	if err != nil {
+		t.Fatalf("NewSQLTokenStorage() error = %v", err)
+	}
+	// Opening an existing database keeps its tables and schema version
+	if _, err := NewSQLTokenStorage(db); err != nil {
+		t.Fatalf("NewSQLTokenStorage() on an existing database error = %v", err)
+	}
+	var version string
+	var versions int
+	if err := db.QueryRow(`SELECT value, COUNT(*) FROM sdk_storage_adapter_internal WHERE attribute = ?`,
+		"globus-sdk.database_schema_version").Scan(&version, &versions); err != nil || version != "2" || versions != 1 {
+		t.Errorf("Schema version = %q (%d rows), %v, want one row with 2", version, versions, err)
+	}
+
+	// A row written by the Python SDK
+	if _, err := db.Exec(`INSERT INTO token_storage (namespace, resource_server, token_data_json) VALUES (?, ?, ?)`,
+		DefaultNamespace, "transfer.api.globus.org", `{"resource_server": "transfer.api.globus.org",
+		"identity_id": "ae341a98-d274-11e5-b888-dbae3a8ba545", "scope": "urn:globus:auth:scope:transfer.api.globus.org:all",
+		"access_token": "py-access", "refresh_token": "py-refresh", "expires_at_seconds": 1893456000, "token_type": "Bearer"}`); err != nil {
+		t.Fatalf("INSERT error = %v", err)
+	}
+
+	entry, err := storage.Lookup("transfer.api.globus.org")
+	if err != nil || entry == nil || entry.RefreshToken != "py-refresh" {
+		t.Fatalf("Lookup() = %+v, %v", entry, err)
+	}
+
+	// Storing again replaces the row rather than adding one
+	entry.AccessToken = "go-access"
+	entry.TokenSet = nil
+	for i := 0; i < 2; i++ {
+		if err := storage.Store(entry); err != nil {
+			t.Fatalf("Store() error = %v", err)
+		}
+	}
+	var rows int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM token_storage`).Scan(&rows); err != nil || rows != 1 {
+		t.Errorf("token_storage rows = %d, %v, want 1", rows, err)
+	}
+	if stored, err := storage.Lookup("transfer.api.globus.org"); err != nil || stored == nil || stored.AccessToken != "go-access" {
+		t.Errorf("Lookup() after Store() = %+v, %v", stored, err)
+	}
+
+	user := storage.Namespace("user-1")
+	if err := user.Store(&Entry{Resource: "groups.api.globus.org", AccessToken: "g"}); err != nil {
+		t.Fatalf("Namespace Store() error = %v", err)
+	}
+	if resources, err := storage.List(); err != nil || len(resources) != 1 {
+		t.Errorf("List() = %v, %v, want only the default namespace", resources, err)
+	}
+	if namespaces, err := storage.Namespaces(); err != nil || len(namespaces) != 2 {
+		t.Errorf("Namespaces() = %v, %v", namespaces, err)
+	}
+
+	if err := user.Delete("groups.api.globus.org"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if entry, err := user.Lookup("groups.api.globus.org"); entry != nil || err != nil {
+		t.Errorf("Lookup() after delete = %v, %v", entry, err)
+	}
+}
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package tokens
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeTokenDB is an in-memory stand-in for a SQLite database that understands
+// exactly the statements issued by SQLTokenStorage
+type fakeTokenDB struct {
+	mu       sync.Mutex
+	tokens   map[[2]string]string
+	internal map[string]string
+}
+
+func (db *fakeTokenDB) Open(string) (driver.Conn, error) { return &fakeTokenConn{db: db}, nil }
+
+type fakeTokenConn struct{ db *fakeTokenDB }
+
+func (c *fakeTokenConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeTokenStmt{db: c.db, query: strings.Join(strings.Fields(query), " ")}, nil
+}
+func (c *fakeTokenConn) Close() error { return nil }
+func (c *fakeTokenConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("transactions not supported")
+}
+
+type fakeTokenStmt struct {
+	db    *fakeTokenDB
+	query string
+}
+
+func (s *fakeTokenStmt) Close() error  { return nil }
+func (s *fakeTokenStmt) NumInput() int { return strings.Count(s.query, "?") }
+
+func (s *fakeTokenStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(s.query, "CREATE TABLE IF NOT EXISTS"):
+	case strings.HasPrefix(s.query, "INSERT OR IGNORE INTO sdk_storage_adapter_internal"):
+		if _, ok := s.db.internal[args[0].(string)]; !ok {
+			s.db.internal[args[0].(string)] = args[1].(string)
+		}
+	case strings.HasPrefix(s.query, "INSERT OR REPLACE INTO token_storage"):
+		s.db.tokens[[2]string{args[0].(string), args[1].(string)}] = args[2].(string)
+	case strings.HasPrefix(s.query, "DELETE FROM token_storage"):
+		delete(s.db.tokens, [2]string{args[0].(string), args[1].(string)})
+	default:
+		return nil, fmt.Errorf("unexpected exec %q", s.query)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeTokenStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	var values []string
+	switch {
+	case strings.HasPrefix(s.query, "SELECT token_data_json"):
+		if payload, ok := s.db.tokens[[2]string{args[0].(string), args[1].(string)}]; ok {
+			values = append(values, payload)
+		}
+	case strings.HasPrefix(s.query, "SELECT resource_server"):
+		for key := range s.db.tokens {
+			if key[0] == args[0].(string) {
+				values = append(values, key[1])
+			}
+		}
+	case strings.HasPrefix(s.query, "SELECT DISTINCT namespace"):
+		seen := make(map[string]bool)
+		for key := range s.db.tokens {
+			if !seen[key[0]] {
+				seen[key[0]] = true
+				values = append(values, key[0])
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unexpected query %q", s.query)
+	}
+	sort.Strings(values)
+	return &fakeTokenRows{values: values}, nil
+}
+
+type fakeTokenRows struct {
+	values []string
+	next   int
+}
+
+func (r *fakeTokenRows) Columns() []string { return []string{"value"} }
+func (r *fakeTokenRows) Close() error      { return nil }
+func (r *fakeTokenRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	dest[0] = r.values[r.next]
+	r.next++
+	return nil
+}
+
+// fakeTokenDrivers counts registered fake drivers, since sql.Register
+// rejects duplicate names
+var fakeTokenDrivers int32
+
+func openFakeTokenDB(t *testing.T) (*sql.DB, *fakeTokenDB) {
+	t.Helper()
+	fake := &fakeTokenDB{tokens: make(map[[2]string]string), internal: make(map[string]string)}
+	name := fmt.Sprintf("fake-token-db-%d", atomic.AddInt32(&fakeTokenDrivers, 1))
+	sql.Register(name, fake)
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, fake
+}
+
+func TestSQLTokenStorage(t *testing.T) {
+	db, fake := openFakeTokenDB(t)
+
+	// A row written by the Python SDK
+	fake.tokens[[2]string{DefaultNamespace, "transfer.api.globus.org"}] = `{"resource_server": "transfer.api.globus.org",
+		"identity_id": "ae341a98-d274-11e5-b888-dbae3a8ba545", "scope": "urn:globus:auth:scope:transfer.api.globus.org:all",
+		"access_token": "py-access", "refresh_token": "py-refresh", "expires_at_seconds": 1893456000, "token_type": "Bearer"}`
+
+	storage, err := NewSQLTokenStorage(db)
+	if err != nil {
+		t.Fatalf("NewSQLTokenStorage() error = %v", err)
+	}
+	if fake.internal["globus-sdk.database_schema_version"] != "2" {
+		t.Errorf("Schema version = %q, want 2", fake.internal["globus-sdk.database_schema_version"])
+	}
+
+	entry, err := storage.Lookup("transfer.api.globus.org")
+	if err != nil || entry == nil || entry.RefreshToken != "py-refresh" {
+		t.Fatalf("Lookup() = %+v, %v", entry, err)
+	}
+
+	entry.AccessToken = "go-access"
+	entry.TokenSet = nil
+	entry.ExpiresAt = time.Unix(1900000000, 0)
+	if err := storage.Store(entry); err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+	var record map[string]interface{}
+	json.Unmarshal([]byte(fake.tokens[[2]string{DefaultNamespace, "transfer.api.globus.org"}]), &record)
+	if record["access_token"] != "go-access" || record["identity_id"] != "ae341a98-d274-11e5-b888-dbae3a8ba545" {
+		t.Errorf("Stored record = %v", record)
+	}
+
+	user := storage.Namespace("user-1")
+	if err := user.Store(&Entry{Resource: "groups.api.globus.org", AccessToken: "g"}); err != nil {
+		t.Fatalf("Namespace Store() error = %v", err)
+	}
+	if resources, err := storage.List(); err != nil || len(resources) != 1 {
+		t.Errorf("List() = %v, %v, want only the default namespace", resources, err)
+	}
+	if namespaces, err := storage.Namespaces(); err != nil || len(namespaces) != 2 {
+		t.Errorf("Namespaces() = %v, %v", namespaces, err)
+	}
+
+	if err := user.Delete("groups.api.globus.org"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if entry, err := user.Lookup("groups.api.globus.org"); entry != nil || err != nil {
+		t.Errorf("Lookup() after delete = %v, %v", entry, err)
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 )
@@ -203,13 +205,9 @@ func (s *FileStorage) Store(entry *Entry) error {
 		return fmt.Errorf("failed to marshal token entry: %w", err)
 	}
 
-	// Write to file
+	// Replace the file atomically so other processes never read a partial entry
 	filename := filepath.Join(s.directory, sanitizeFilename(entry.Resource))
-	if err := os.WriteFile(filename, data, 0600); err != nil {
-		return fmt.Errorf("failed to write token file: %w", err)
-	}
-
-	return nil
+	return writeFileAtomic(filename, data)
 }
 
 // Lookup implements Storage.Lookup for file-based storage
@@ -271,18 +269,41 @@ func (s *FileStorage) List() ([]string, error) {
 
 	resources := make([]string, 0, len(entries))
 	for _, entry := range entries {
-		if !entry.IsDir() {
-			resources = append(resources, entry.Name())
+		// Skip lock and temporary files
+		if !entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			resources = append(resources, resourceFromFilename(entry.Name()))
 		}
 	}
 
 	return resources, nil
 }
 
-// sanitizeFilename sanitizes a resource ID for use as a filename
+// sanitizeFilename maps a resource name to a single file name in the storage
+// directory. Bytes other than letters, digits, '-', '_' and '.', and a
+// leading or trailing '.', are written as %XX, so namespaced keys such as
+// "user-<id>::<resource>" are valid file names on every platform and names
+// containing path separators cannot leave the directory. Plain resource
+// names map to themselves, so existing storage directories keep working.
 func sanitizeFilename(resource string) string {
-	// This is a simple implementation - in a real application,
-	// you might want to use a more sophisticated approach
-	// such as base64 encoding or a hash function
+	var b strings.Builder
+	for i := 0; i < len(resource); i++ {
+		c := resource[i]
+		safe := c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+			c == '-' || c == '_' || c == '.' && i > 0 && i < len(resource)-1
+		if safe {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// resourceFromFilename reverses sanitizeFilename
+func resourceFromFilename(name string) string {
+	resource, err := url.PathUnescape(name)
+	if err != nil {
+		return name
+	}
 	return resource
 }
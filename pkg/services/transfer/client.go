@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -124,6 +125,12 @@ func (c *Client) doRequestLowLevel(ctx context.Context, method, path string, que
 
 	resp, err := c.Client.Do(ctx, req)
 	if err != nil {
+		// Surface error responses as Transfer errors, including consent
+		// requirements so callers can re-authenticate with the required scopes
+		var coreErr *core.Error
+		if errors.As(err, &coreErr) {
+			return parseTransferError(coreErr.StatusCode, coreErr.RawBody, coreErr)
+		}
 		return err
 	}
 	defer resp.Body.Close()
@@ -131,18 +138,7 @@ func (c *Client) doRequestLowLevel(ctx context.Context, method, path string, que
 	// Check for non-success status code
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return parseTransferError(resp.StatusCode, respBody)
-	}
-
-	// Process rate limit headers if present
-	if limiter := c.Client.RateLimiter; limiter != nil {
-		limit := parseIntHeader(resp.Header, "X-RateLimit-Limit", -1)
-		remaining := parseIntHeader(resp.Header, "X-RateLimit-Remaining", -1)
-		reset := parseIntHeader(resp.Header, "X-RateLimit-Reset", -1)
-
-		if limit > 0 && remaining >= 0 && reset > 0 {
-			limiter.UpdateLimit(limit, remaining, reset)
-		}
+		return parseTransferError(resp.StatusCode, respBody, nil)
 	}
 
 	// Process 204 No Content or empty responses
@@ -352,18 +348,6 @@ func (c *Client) CreateTransferTask(ctx context.Context, request *TransferTaskRe
 		return nil, fmt.Errorf("transfer task request is required")
 	}
 
-	if request.SourceEndpointID == "" {
-		return nil, fmt.Errorf("source endpoint is required")
-	}
-
-	if request.DestinationEndpointID == "" {
-		return nil, fmt.Errorf("destination endpoint is required")
-	}
-
-	if len(request.Items) == 0 {
-		return nil, fmt.Errorf("at least one transfer item is required")
-	}
-
 	// Set data type if not already set
 	if request.DataType == "" {
 		request.DataType = "transfer"
@@ -376,6 +360,11 @@ func (c *Client) CreateTransferTask(ctx context.Context, request *TransferTaskRe
 		}
 	}
 
+	// Reject malformed requests before contacting the service
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Get a submission ID if not provided
 	if request.SubmissionID == "" {
 		var err error
@@ -400,14 +389,6 @@ func (c *Client) CreateDeleteTask(ctx context.Context, request *DeleteTaskReques
 		return nil, fmt.Errorf("delete task request is required")
 	}
 
-	if request.EndpointID == "" {
-		return nil, fmt.Errorf("endpoint is required")
-	}
-
-	if len(request.Items) == 0 {
-		return nil, fmt.Errorf("at least one delete item is required")
-	}
-
 	// Set data type if not already set
 	if request.DataType == "" {
 		request.DataType = "delete"
@@ -420,6 +401,11 @@ func (c *Client) CreateDeleteTask(ctx context.Context, request *DeleteTaskReques
 		}
 	}
 
+	// Reject malformed requests before contacting the service
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Note: The API does not support a "recursive" field for delete_item as of API v0.10
 	// Instead, all deletions in Globus Transfer appear to be recursive by default
 
@@ -505,6 +491,27 @@ func (c *Client) GetTask(ctx context.Context, taskID string) (*Task, error) {
 	return &task, nil
 }
 
+// ListSuccessfulTransfers retrieves a page of files a task transferred successfully.
+// Pass the previous page's NextMarker to fetch the following page.
+func (c *Client) ListSuccessfulTransfers(ctx context.Context, taskID, marker string) (*SuccessfulTransferList, error) {
+	if taskID == "" {
+		return nil, fmt.Errorf("task ID is required")
+	}
+
+	query := url.Values{}
+	if marker != "" {
+		query.Set("marker", marker)
+	}
+
+	var list SuccessfulTransferList
+	err := c.doRequestLowLevel(ctx, http.MethodGet, "task/"+taskID+"/successful_transfers", query, nil, &list)
+	if err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
 // CancelTask cancels a task
 func (c *Client) CancelTask(ctx context.Context, taskID string) (*OperationResult, error) {
 	if taskID == "" {
@@ -664,6 +671,11 @@ func (c *Client) SubmitTransfer(
 		}
 	}
 
+	// Reject malformed requests before requesting a submission ID
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Get a submission ID
 	submissionID, err := c.GetSubmissionID(ctx)
 	if err != nil {
@@ -709,22 +721,3 @@ func (c *Client) CancelResumableTransfer(
 ) error {
 	return c.DeleteTransferCheckpoint(ctx, checkpointID)
 }
-
-// parseIntHeader parses an integer header value with a default fallback
-func parseIntHeader(header http.Header, key string, defaultValue int) int {
-	if header == nil {
-		return defaultValue
-	}
-
-	value := header.Get(key)
-	if value == "" {
-		return defaultValue
-	}
-
-	intValue, err := strconv.Atoi(value)
-	if err != nil {
-		return defaultValue
-	}
-
-	return intValue
-}
@@ -421,11 +421,11 @@ func TestCreateTransferTask(t *testing.T) {
 		if requestBody.Label != "Test Transfer" {
 			t.Errorf("Expected Label=Test Transfer, got %s", requestBody.Label)
 		}
-		if requestBody.SourceEndpointID != "source-endpoint" {
-			t.Errorf("Expected SourceEndpointID=source-endpoint, got %s", requestBody.SourceEndpointID)
+		if requestBody.SourceEndpointID != "6f1e2a3b-0000-4000-8000-00000000a001" {
+			t.Errorf("Expected SourceEndpointID=6f1e2a3b-0000-4000-8000-00000000a001, got %s", requestBody.SourceEndpointID)
 		}
-		if requestBody.DestinationEndpointID != "destination-endpoint" {
-			t.Errorf("Expected DestinationEndpointID=destination-endpoint, got %s", requestBody.DestinationEndpointID)
+		if requestBody.DestinationEndpointID != "6f1e2a3b-0000-4000-8000-00000000b002" {
+			t.Errorf("Expected DestinationEndpointID=6f1e2a3b-0000-4000-8000-00000000b002, got %s", requestBody.DestinationEndpointID)
 		}
 		if len(requestBody.Items) != 1 {
 			t.Fatalf("Expected 1 transfer item, got %d", len(requestBody.Items))
@@ -456,8 +456,8 @@ func TestCreateTransferTask(t *testing.T) {
 	request := &TransferTaskRequest{
 		DataType:              "transfer",
 		Label:                 "Test Transfer",
-		SourceEndpointID:      "source-endpoint",
-		DestinationEndpointID: "destination-endpoint",
+		SourceEndpointID:      "6f1e2a3b-0000-4000-8000-00000000a001",
+		DestinationEndpointID: "6f1e2a3b-0000-4000-8000-00000000b002",
 		Items: []TransferItem{
 			{
 				SourcePath:      "/source/file.txt",
@@ -488,7 +488,7 @@ func TestCreateTransferTask(t *testing.T) {
 	// Test with missing source endpoint
 	_, err = client.CreateTransferTask(context.Background(), &TransferTaskRequest{
 		DataType:              "transfer",
-		DestinationEndpointID: "destination-endpoint",
+		DestinationEndpointID: "6f1e2a3b-0000-4000-8000-00000000b002",
 		Items: []TransferItem{
 			{
 				SourcePath:      "/source/file.txt",
@@ -503,7 +503,7 @@ func TestCreateTransferTask(t *testing.T) {
 	// Test with missing destination endpoint
 	_, err = client.CreateTransferTask(context.Background(), &TransferTaskRequest{
 		DataType:         "transfer",
-		SourceEndpointID: "source-endpoint",
+		SourceEndpointID: "6f1e2a3b-0000-4000-8000-00000000a001",
 		Items: []TransferItem{
 			{
 				SourcePath:      "/source/file.txt",
@@ -518,8 +518,8 @@ func TestCreateTransferTask(t *testing.T) {
 	// Test with no items
 	_, err = client.CreateTransferTask(context.Background(), &TransferTaskRequest{
 		DataType:              "transfer",
-		SourceEndpointID:      "source-endpoint",
-		DestinationEndpointID: "destination-endpoint",
+		SourceEndpointID:      "6f1e2a3b-0000-4000-8000-00000000a001",
+		DestinationEndpointID: "6f1e2a3b-0000-4000-8000-00000000b002",
 		Items:                 []TransferItem{},
 	})
 	if err == nil {
@@ -552,11 +552,11 @@ func TestSubmitTransfer(t *testing.T) {
 		if requestBody.Label != "Test Label" {
 			t.Errorf("Expected Label=Test Label, got %s", requestBody.Label)
 		}
-		if requestBody.SourceEndpointID != "source-endpoint" {
-			t.Errorf("Expected SourceEndpointID=source-endpoint, got %s", requestBody.SourceEndpointID)
+		if requestBody.SourceEndpointID != "6f1e2a3b-0000-4000-8000-00000000a001" {
+			t.Errorf("Expected SourceEndpointID=6f1e2a3b-0000-4000-8000-00000000a001, got %s", requestBody.SourceEndpointID)
 		}
-		if requestBody.DestinationEndpointID != "destination-endpoint" {
-			t.Errorf("Expected DestinationEndpointID=destination-endpoint, got %s", requestBody.DestinationEndpointID)
+		if requestBody.DestinationEndpointID != "6f1e2a3b-0000-4000-8000-00000000b002" {
+			t.Errorf("Expected DestinationEndpointID=6f1e2a3b-0000-4000-8000-00000000b002, got %s", requestBody.DestinationEndpointID)
 		}
 		if !requestBody.VerifyChecksum {
 			t.Errorf("Expected VerifyChecksum=true, got %v", requestBody.VerifyChecksum)
@@ -586,8 +586,8 @@ func TestSubmitTransfer(t *testing.T) {
 
 	response, err := client.SubmitTransfer(
 		context.Background(),
-		"source-endpoint", "/source/path",
-		"destination-endpoint", "/destination/path",
+		"6f1e2a3b-0000-4000-8000-00000000a001", "/source/path",
+		"6f1e2a3b-0000-4000-8000-00000000b002", "/destination/path",
 		"Test Label",
 		options,
 	)
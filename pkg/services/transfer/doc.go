@@ -39,6 +39,9 @@ significantly or be removed:
   - Resumable transfers
   - Memory-optimized operations
   - Streaming iterator functionality
+  - Endpoint readiness checks (CheckEndpointReadiness)
+  - Client-side transfer scheduling (TransferScheduler)
+  - Post-transfer verification (VerifyTransfer, VerifyTransferTask)
 
 # Compatibility Notes
 
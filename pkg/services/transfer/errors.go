@@ -42,6 +42,9 @@ const (
 	ErrCodeNoSuchPath         = "NoSuchPath"
 	ErrCodeNotADirectory      = "NotADirectory"
 	ErrCodePathCreationFailed = "PathCreationFailed"
+
+	// Authorization error codes
+	ErrCodeConsentRequired = "ConsentRequired"
 )
 
 // Common errors that can be directly checked
@@ -71,30 +74,180 @@ var (
 	ErrFileExists        = errors.New("file already exists")
 	ErrNoSuchPath        = errors.New("no such path")
 	ErrNotADirectory     = errors.New("not a directory")
+
+	// Authorization errors
+	ErrConsentRequired = errors.New("consent required")
 )
 
-// TransferError represents an error from the Globus Transfer API
+// codeErrors maps Transfer error codes to the errors in this package that
+// they match with errors.Is
+var codeErrors = map[string][]error{
+	ErrCodeResourceNotFound:       {ErrResourceNotFound},
+	ErrCodeEndpointNotFound:       {ErrEndpointNotFound, ErrResourceNotFound},
+	ErrCodeTaskNotFound:           {ErrTaskNotFound, ErrResourceNotFound},
+	ErrCodeFileNotFound:           {ErrFileNotFound, ErrResourceNotFound},
+	ErrCodeDirectoryNotFound:      {ErrDirectoryNotFound, ErrResourceNotFound},
+	ErrCodeNoSuchPath:             {ErrNoSuchPath, ErrResourceNotFound},
+	ErrCodeFileExists:             {ErrFileExists},
+	ErrCodeNotADirectory:          {ErrNotADirectory},
+	ErrCodePermissionDenied:       {ErrPermissionDenied},
+	ErrCodeRateLimitExceeded:      {ErrRateLimitExceeded},
+	ErrCodeAuthenticationRequired: {ErrAuthenticationRequired},
+	ErrCodeEndpointNotActivated:   {ErrEndpointNotActivated},
+	ErrCodeTaskCompleted:          {ErrTaskCompleted},
+	ErrCodeTaskCanceled:           {ErrTaskCanceled},
+	ErrCodeTaskExpired:            {ErrTaskExpired},
+	ErrCodeServerError:            {ErrServerError},
+	ErrCodeServiceUnavailable:     {ErrServerError},
+	ErrCodeBadRequest:             {ErrBadRequest},
+	ErrCodeConsentRequired:        {ErrConsentRequired},
+}
+
+// statusError returns the error in this package for a response status, used
+// when the response has no Transfer error code
+func statusError(statusCode int) error {
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		return ErrAuthenticationRequired
+	case statusCode == http.StatusForbidden:
+		return ErrPermissionDenied
+	case statusCode == http.StatusNotFound:
+		return ErrResourceNotFound
+	case statusCode == http.StatusTooManyRequests:
+		return ErrRateLimitExceeded
+	case statusCode == http.StatusBadRequest:
+		return ErrBadRequest
+	case statusCode >= 500 && statusCode < 600:
+		return ErrServerError
+	}
+	return nil
+}
+
+// TransferError represents an error from the Globus Transfer API. It matches
+// the errors in this package for its code, such as ErrEndpointNotFound, and
+// the shared core errors for its status, such as core.ErrNotFound.
 type TransferError struct {
 	Code       string `json:"code"`
 	Message    string `json:"message"`
 	Resource   string `json:"resource,omitempty"`
 	RequestID  string `json:"request_id,omitempty"`
 	StatusCode int    `json:"-"`
+	RawBody    []byte `json:"-"`
+	Cause      error  `json:"-"`
 }
 
 // Error returns a string representation of the error
 func (e *TransferError) Error() string {
+	if e.Code == "" {
+		return e.Message
+	}
 	if e.RequestID != "" {
 		return fmt.Sprintf("%s: %s (request_id: %s)", e.Code, e.Message, e.RequestID)
 	}
 	return fmt.Sprintf("%s: %s", e.Code, e.Message)
 }
 
+// Unwrap returns the underlying error, such as the *core.Error the response
+// was read from
+func (e *TransferError) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether target is one of the errors in this package matching
+// the error's code, or a core error matching its status and code
+func (e *TransferError) Is(target error) bool {
+	if core.MatchesError(e.StatusCode, e.Code, target) {
+		return true
+	}
+	if e.Code == "" {
+		return target == statusError(e.StatusCode)
+	}
+	for _, err := range codeErrors[e.Code] {
+		if target == err {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrorCode returns the Transfer error code
+func (e *TransferError) ErrorCode() string {
+	return e.Code
+}
+
+// ErrorRequestID returns the Transfer request ID
+func (e *TransferError) ErrorRequestID() string {
+	return e.RequestID
+}
+
+// ResponseBody returns the raw body of the response that caused the error
+func (e *TransferError) ResponseBody() []byte {
+	return e.RawBody
+}
+
+// HTTPStatus returns the HTTP status code of the response that caused the error
+func (e *TransferError) HTTPStatus() int {
+	return e.StatusCode
+}
+
+// ConsentRequiredError is returned when the user must grant additional consents,
+// such as a collection data_access scope, before the request can succeed
+type ConsentRequiredError struct {
+	*TransferError
+	requiredScopes []string
+}
+
+// RequiredScopes returns the scope strings the user must consent to.
+// They can be passed directly to auth.Client.GetAuthorizationURL.
+func (e *ConsentRequiredError) RequiredScopes() []string {
+	return append([]string(nil), e.requiredScopes...)
+}
+
+// Error returns a string representation of the error
+func (e *ConsentRequiredError) Error() string {
+	if len(e.requiredScopes) == 0 {
+		return e.TransferError.Error()
+	}
+	return fmt.Sprintf("%s (required scopes: %s)", e.TransferError.Error(), strings.Join(e.requiredScopes, " "))
+}
+
+// Unwrap returns the underlying TransferError
+func (e *ConsentRequiredError) Unwrap() error {
+	return e.TransferError
+}
+
+// IsConsentRequired checks if the error indicates additional consent is required
+func IsConsentRequired(err error) bool {
+	return AsConsentRequired(err) != nil || errors.Is(err, ErrConsentRequired)
+}
+
+// AsConsentRequired returns the ConsentRequiredError carried by err, or nil.
+// Errors from the core client are inspected for a ConsentRequired body.
+func AsConsentRequired(err error) *ConsentRequiredError {
+	if err == nil {
+		return nil
+	}
+
+	var consentErr *ConsentRequiredError
+	if errors.As(err, &consentErr) {
+		return consentErr
+	}
+
+	var coreErr *core.Error
+	if errors.As(err, &coreErr) && len(coreErr.RawBody) > 0 {
+		if parsed, ok := parseTransferError(coreErr.StatusCode, coreErr.RawBody, coreErr).(*ConsentRequiredError); ok {
+			return parsed
+		}
+	}
+
+	return nil
+}
+
 // IsResourceNotFound checks if the error indicates a resource not found condition
 func IsResourceNotFound(err error) bool {
-	var transferErr *TransferError
-	if errors.As(err, &transferErr) {
-		return transferErr.Code == ErrCodeResourceNotFound ||
+	if transferErr, ok := asTransferError(err); ok {
+		return transferErr.StatusCode == http.StatusNotFound ||
+			transferErr.Code == ErrCodeResourceNotFound ||
 			transferErr.Code == ErrCodeEndpointNotFound ||
 			transferErr.Code == ErrCodeFileNotFound ||
 			transferErr.Code == ErrCodeDirectoryNotFound ||
@@ -111,8 +264,7 @@ func IsResourceNotFound(err error) bool {
 
 // IsPermissionDenied checks if the error indicates a permission denied condition
 func IsPermissionDenied(err error) bool {
-	var transferErr *TransferError
-	if errors.As(err, &transferErr) {
+	if transferErr, ok := asTransferError(err); ok {
 		return transferErr.Code == ErrCodePermissionDenied
 	}
 	return errors.Is(err, ErrPermissionDenied)
@@ -168,81 +320,114 @@ func IsTaskCompleted(err error) bool {
 	return errors.Is(err, ErrTaskCompleted)
 }
 
-// parseTransferError parses an error response from the Globus Transfer API
-func parseTransferError(statusCode int, respBody []byte) error {
-	// If the response body is empty, return a generic error based on status code
-	if len(respBody) == 0 {
-		switch statusCode {
-		case http.StatusUnauthorized:
-			return ErrAuthenticationRequired
-		case http.StatusForbidden:
-			return ErrPermissionDenied
-		case http.StatusNotFound:
-			return ErrResourceNotFound
-		case http.StatusTooManyRequests:
-			return ErrRateLimitExceeded
-		case http.StatusBadRequest:
-			return ErrBadRequest
-		case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
-			return ErrServerError
-		default:
-			return fmt.Errorf("request failed with status code %d", statusCode)
-		}
+// parseTransferError parses an error response from the Globus Transfer API.
+// It returns a *ConsentRequiredError for ConsentRequired responses and a
+// *TransferError otherwise; cause, if not nil, is the error the response was
+// read from.
+func parseTransferError(statusCode int, respBody []byte, cause error) error {
+	transferErr := &TransferError{
+		StatusCode: statusCode,
+		RawBody:    respBody,
+		Cause:      cause,
 	}
 
 	// Try to parse the error as JSON
 	var errorResp map[string]interface{}
-	if err := json.Unmarshal(respBody, &errorResp); err != nil {
-		// If parsing fails, return the body as a string
-		return fmt.Errorf("request failed with status code %d: %s", statusCode, string(respBody))
+	if len(respBody) == 0 || json.Unmarshal(respBody, &errorResp) != nil {
+		transferErr.Message = fmt.Sprintf("request failed with status code %d", statusCode)
+		if err := statusError(statusCode); err != nil {
+			transferErr.Message = err.Error()
+		}
+		if len(respBody) > 0 {
+			transferErr.Message += ": " + string(respBody)
+		}
+		return transferErr
 	}
 
 	// Check if it's an OperationResult with an error code
 	code, hasCode := errorResp["code"].(string)
 	message, hasMessage := errorResp["message"].(string)
-	if hasCode && hasMessage {
-		transferErr := &TransferError{
-			Code:       code,
-			Message:    message,
-			StatusCode: statusCode,
+	if !hasCode || !hasMessage {
+		// Handle other error formats
+		transferErr.Message = fmt.Sprintf("request failed with status code %d: %s", statusCode, string(respBody))
+		return transferErr
+	}
+
+	transferErr.Code = code
+	transferErr.Message = message
+
+	// Extract optional fields if present
+	if resource, ok := errorResp["resource"].(string); ok {
+		transferErr.Resource = resource
+	}
+	if requestID, ok := errorResp["request_id"].(string); ok {
+		transferErr.RequestID = requestID
+	}
+
+	if code == ErrCodeConsentRequired {
+		return &ConsentRequiredError{
+			TransferError:  transferErr,
+			requiredScopes: parseRequiredScopes(errorResp),
 		}
+	}
 
-		// Extract optional fields if present
-		if resource, ok := errorResp["resource"].(string); ok {
-			transferErr.Resource = resource
+	return transferErr
+}
+
+// parseRequiredScopes extracts the required_scopes list from a ConsentRequired
+// error body. Transfer reports them at the top level and, for some operations,
+// inside an authorization_parameters object.
+func parseRequiredScopes(errorResp map[string]interface{}) []string {
+	raw, ok := errorResp["required_scopes"].([]interface{})
+	if !ok {
+		if params, ok := errorResp["authorization_parameters"].(map[string]interface{}); ok {
+			raw, _ = params["required_scopes"].([]interface{})
 		}
-		if requestID, ok := errorResp["request_id"].(string); ok {
-			transferErr.RequestID = requestID
+	}
+
+	scopes := make([]string, 0, len(raw))
+	for _, scope := range raw {
+		if s, ok := scope.(string); ok && s != "" {
+			scopes = append(scopes, s)
 		}
+	}
+	return scopes
+}
+
+// asTransferError extracts a TransferError from err. Errors returned by the
+// core client carry the raw Transfer response body, which is decoded when it
+// contains a Transfer-style code and message.
+func asTransferError(err error) (*TransferError, bool) {
+	var transferErr *TransferError
+	if errors.As(err, &transferErr) {
+		return transferErr, true
+	}
 
-		// Map common error codes to standard errors for easier checking
-		switch code {
-		case ErrCodeResourceNotFound, ErrCodeEndpointNotFound, ErrCodeTaskNotFound,
-			ErrCodeFileNotFound, ErrCodeDirectoryNotFound, ErrCodeNoSuchPath:
-			return fmt.Errorf("%w: %s", ErrResourceNotFound, transferErr.Error())
-		case ErrCodePermissionDenied:
-			return fmt.Errorf("%w: %s", ErrPermissionDenied, transferErr.Error())
-		case ErrCodeRateLimitExceeded:
-			return fmt.Errorf("%w: %s", ErrRateLimitExceeded, transferErr.Error())
-		case ErrCodeAuthenticationRequired:
-			return fmt.Errorf("%w: %s", ErrAuthenticationRequired, transferErr.Error())
-		case ErrCodeEndpointNotActivated:
-			return fmt.Errorf("%w: %s", ErrEndpointNotActivated, transferErr.Error())
-		case ErrCodeTaskCompleted:
-			return fmt.Errorf("%w: %s", ErrTaskCompleted, transferErr.Error())
-		case ErrCodeTaskCanceled:
-			return fmt.Errorf("%w: %s", ErrTaskCanceled, transferErr.Error())
-		case ErrCodeTaskExpired:
-			return fmt.Errorf("%w: %s", ErrTaskExpired, transferErr.Error())
-		case ErrCodeServerError, ErrCodeServiceUnavailable:
-			return fmt.Errorf("%w: %s", ErrServerError, transferErr.Error())
-		default:
-			return transferErr
+	var coreErr *core.Error
+	if errors.As(err, &coreErr) {
+		parsed := &TransferError{
+			Code:       coreErr.Code,
+			Message:    coreErr.Message,
+			Resource:   coreErr.Resource,
+			StatusCode: coreErr.StatusCode,
+			RawBody:    coreErr.RawBody,
+		}
+		var body struct {
+			Code      string `json:"code"`
+			Message   string `json:"message"`
+			Resource  string `json:"resource"`
+			RequestID string `json:"request_id"`
+		}
+		if len(coreErr.RawBody) > 0 && json.Unmarshal(coreErr.RawBody, &body) == nil && body.Code != "" {
+			parsed.Code = body.Code
+			parsed.Message = body.Message
+			parsed.Resource = body.Resource
+			parsed.RequestID = body.RequestID
 		}
+		return parsed, true
 	}
 
-	// Handle other error formats
-	return fmt.Errorf("request failed with status code %d: %s", statusCode, string(respBody))
+	return nil, false
 }
 
 // IsRetryableTransferError determines if a Globus Transfer API error should be retried
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+func TestParseTransferErrorConsentRequired(t *testing.T) {
+	body := []byte(`{
+		"code": "ConsentRequired",
+		"message": "Missing required data_access consent",
+		"request_id": "req-123",
+		"required_scopes": [
+			"urn:globus:auth:scope:transfer.api.globus.org:all[*https://auth.globus.org/scopes/c1/data_access]"
+		]
+	}`)
+
+	err := parseTransferError(http.StatusForbidden, body, nil)
+
+	if !IsConsentRequired(err) {
+		t.Fatalf("IsConsentRequired() = false for %v", err)
+	}
+	if !errors.Is(err, ErrConsentRequired) {
+		t.Error("errors.Is(err, ErrConsentRequired) = false, want true")
+	}
+
+	consentErr := AsConsentRequired(err)
+	if consentErr == nil {
+		t.Fatal("AsConsentRequired() = nil")
+	}
+	scopes := consentErr.RequiredScopes()
+	if len(scopes) != 1 || scopes[0] != "urn:globus:auth:scope:transfer.api.globus.org:all[*https://auth.globus.org/scopes/c1/data_access]" {
+		t.Errorf("RequiredScopes() = %v", scopes)
+	}
+
+	var transferErr *TransferError
+	if !errors.As(err, &transferErr) {
+		t.Fatal("errors.As(err, *TransferError) = false, want true")
+	}
+	if transferErr.RequestID != "req-123" || transferErr.StatusCode != http.StatusForbidden {
+		t.Errorf("TransferError = %+v", transferErr)
+	}
+}
+
+func TestParseTransferErrorConsentRequiredAuthorizationParameters(t *testing.T) {
+	body := []byte(`{
+		"code": "ConsentRequired",
+		"message": "Missing required data_access consent",
+		"authorization_parameters": {
+			"required_scopes": ["scope-a", "scope-b"]
+		}
+	}`)
+
+	consentErr := AsConsentRequired(parseTransferError(http.StatusForbidden, body, nil))
+	if consentErr == nil {
+		t.Fatal("AsConsentRequired() = nil")
+	}
+	if got := consentErr.RequiredScopes(); len(got) != 2 || got[1] != "scope-b" {
+		t.Errorf("RequiredScopes() = %v, want [scope-a scope-b]", got)
+	}
+
+	// The body is kept so other packages can read the authorization parameters
+	if string(consentErr.ResponseBody()) != string(body) || consentErr.HTTPStatus() != http.StatusForbidden {
+		t.Errorf("ResponseBody() = %s, HTTPStatus() = %d", consentErr.ResponseBody(), consentErr.HTTPStatus())
+	}
+}
+
+func TestCreateTransferTaskConsentRequired(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"code":            "ConsentRequired",
+			"message":         "Missing required data_access consent",
+			"required_scopes": []string{"required-scope"},
+		})
+	}
+
+	server, client := setupMockServer(handler)
+	defer server.Close()
+
+	_, err := client.CreateTransferTask(context.Background(), &TransferTaskRequest{
+		SourceEndpointID:      testSourceEndpointID,
+		DestinationEndpointID: testDestinationEndpointID,
+		Items:                 []TransferItem{{SourcePath: "/a", DestinationPath: "/b"}},
+	})
+	if err == nil {
+		t.Fatal("CreateTransferTask() error = nil, want consent error")
+	}
+
+	consentErr := AsConsentRequired(err)
+	if consentErr == nil {
+		t.Fatalf("AsConsentRequired() = nil for %v", err)
+	}
+	if got := consentErr.RequiredScopes(); len(got) != 1 || got[0] != "required-scope" {
+		t.Errorf("RequiredScopes() = %v, want [required-scope]", got)
+	}
+	var coreErr *core.Error
+	if !errors.As(err, &coreErr) || coreErr.StatusCode != http.StatusForbidden {
+		t.Errorf("errors.As(%v, *core.Error) did not find the core error", err)
+	}
+
+	if IsConsentRequired(errors.New("unrelated")) {
+		t.Error("IsConsentRequired() = true for unrelated error")
+	}
+}
+
+func TestTransferErrorTaxonomy(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"code":       "EndpointNotFound",
+			"message":    "No such endpoint",
+			"request_id": "req-1",
+		})
+	}
+
+	server, client := setupMockServer(handler)
+	defer server.Close()
+
+	_, err := client.GetEndpoint(context.Background(), testSourceEndpointID)
+	for _, target := range []error{ErrEndpointNotFound, ErrResourceNotFound, core.ErrNotFound} {
+		if !errors.Is(err, target) {
+			t.Errorf("errors.Is(%v, %v) = false", err, target)
+		}
+	}
+	if errors.Is(err, ErrTaskNotFound) || errors.Is(err, core.ErrForbidden) {
+		t.Errorf("errors.Is(%v) matched an unrelated error", err)
+	}
+
+	var transferErr *TransferError
+	if !errors.As(err, &transferErr) || transferErr.RequestID != "req-1" || len(transferErr.RawBody) == 0 {
+		t.Fatalf("errors.As(%v, *TransferError) = %+v", err, transferErr)
+	}
+	var apiErr core.APIError
+	if !errors.As(err, &apiErr) || apiErr.HTTPStatus() != http.StatusNotFound || apiErr.ErrorCode() != ErrCodeEndpointNotFound {
+		t.Errorf("errors.As(%v, core.APIError) = %v", err, apiErr)
+	}
+
+	// Responses without a Transfer error code match on their status
+	emptyErr := parseTransferError(http.StatusTooManyRequests, nil, nil)
+	if !errors.Is(emptyErr, ErrRateLimitExceeded) || !errors.Is(emptyErr, core.ErrRateLimited) || !IsRetryableTransferError(emptyErr) {
+		t.Errorf("parseTransferError(429) = %v, want a rate limit error", emptyErr)
+	}
+
+	consentErr := parseTransferError(http.StatusForbidden, []byte(`{"code": "ConsentRequired", "message": "Missing consent"}`), nil)
+	if !errors.Is(consentErr, core.ErrConsentRequired) || !errors.Is(consentErr, ErrConsentRequired) || !errors.Is(consentErr, core.ErrForbidden) {
+		t.Errorf("parseTransferError(ConsentRequired) = %v, want a consent error", consentErr)
+	}
+}
@@ -131,7 +131,7 @@ func TestMemoryOptimizedTransfer(t *testing.T) {
 			w.WriteHeader(http.StatusOK)
 			fmt.Fprintf(w, `{"value": "submission-id-123456"}`)
 
-		case r.URL.Path == "/v0.10/operation/endpoint/mock-source/ls" && r.Method == http.MethodGet:
+		case r.URL.Path == "/v0.10/operation/endpoint/6f1e2a3b-0000-4000-8000-00000000a001/ls" && r.Method == http.MethodGet:
 			// Handle listing files in source directory
 			// This is called by the memory optimized transfer function to get the file list
 			w.WriteHeader(http.StatusOK)
@@ -150,7 +150,7 @@ func TestMemoryOptimizedTransfer(t *testing.T) {
 					{"data_type": "file", "name": "file11.txt", "type": "file", "size": 11264, "last_modified": "2021-01-01T00:00:00Z"},
 					{"data_type": "file", "name": "file12.txt", "type": "file", "size": 12288, "last_modified": "2021-01-01T00:00:00Z"}
 				],
-				"endpoint_id": "mock-source",
+				"endpoint_id": "6f1e2a3b-0000-4000-8000-00000000a001",
 				"path": "/source",
 				"data_type": "file_list",
 				"has_next_page": false
@@ -225,8 +225,8 @@ func TestMemoryOptimizedTransfer(t *testing.T) {
 		// Submit memory-optimized transfer
 		result, err := client.SubmitMemoryOptimizedTransfer(
 			ctx,
-			"mock-source", "/source",
-			"mock-dest", "/dest",
+			"6f1e2a3b-0000-4000-8000-00000000a001", "/source",
+			"6f1e2a3b-0000-4000-8000-00000000b002", "/dest",
 			&MemoryOptimizedOptions{
 				BatchSize:          5,
 				MaxConcurrentTasks: 2,
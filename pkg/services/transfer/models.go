@@ -41,6 +41,7 @@ type Endpoint struct {
 	OAuth                  bool                   `json:"oauth_server,omitempty"`
 	DeactivationTime       *time.Time             `json:"deactivation_time,omitempty"`
 	Activated              bool                   `json:"activated"`
+	IsGlobusConnect        bool                   `json:"is_globus_connect,omitempty"`
 	GCPPaused              bool                   `json:"gcp_paused,omitempty"`
 	GCPConnected           bool                   `json:"gcp_connected,omitempty"`
 	HibernationState       string                 `json:"hibernation_state,omitempty"`
@@ -140,6 +141,9 @@ type Task struct {
 // TaskList represents a paginated list of tasks
 type TaskList struct {
 	Data          []Task `json:"data"`
+	Offset        int    `json:"offset"`
+	Limit         int    `json:"limit"`
+	Total         int    `json:"total"`
 	NextPageToken string `json:"next_page_token,omitempty"`
 	NextMarker    string `json:"next_marker,omitempty"` // Alternative name for NextPageToken
 	HasNextPage   bool   `json:"has_next_page"`
@@ -224,6 +228,20 @@ type TaskResponse struct {
 	TransferDetails interface{} `json:"transfer_details,omitempty"`
 }
 
+// SuccessfulTransfer is a file that a task transferred successfully
+type SuccessfulTransfer struct {
+	DataType        string `json:"DATA_TYPE"`
+	SourcePath      string `json:"source_path"`
+	DestinationPath string `json:"destination_path"`
+}
+
+// SuccessfulTransferList is a page of successfully transferred files
+type SuccessfulTransferList struct {
+	Data       []SuccessfulTransfer `json:"DATA"`
+	Marker     string               `json:"marker,omitempty"`
+	NextMarker string               `json:"next_marker,omitempty"`
+}
+
 // OperationResult represents the result of an operation
 type OperationResult struct {
 	Code      string      `json:"code"`
@@ -248,6 +266,7 @@ type FileListItem struct {
 	User         string `json:"user,omitempty"`
 	Group        string `json:"group,omitempty"`
 	Link         string `json:"link_target,omitempty"`
+	Checksum     string `json:"checksum,omitempty"`
 }
 
 // FileList represents a paginated list of files and directories
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package transfer
+
+import (
+	"context"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+// AllEndpoints returns a pager over every endpoint matching the options,
+// fetching pages with ListEndpoints
+func (c *Client) AllEndpoints(ctx context.Context, options *ListEndpointsOptions) *core.Pager[Endpoint] {
+	var base ListEndpointsOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[Endpoint], error) {
+		pageOptions := base
+		if request.Marker != "" {
+			pageOptions.PageToken = request.Marker
+		} else {
+			pageOptions.Offset = base.Offset + request.Offset
+		}
+		list, err := c.ListEndpoints(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[Endpoint]{Items: list.Data, HasNextPage: list.HasNextPage, NextMarker: list.NextPageToken}, nil
+	})
+}
+
+// AllTasks returns a pager over every task matching the options, fetching
+// pages with ListTasks. The task list pages by offset and reports the total
+// number of matching tasks.
+func (c *Client) AllTasks(ctx context.Context, options *ListTasksOptions) *core.Pager[Task] {
+	var base ListTasksOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[Task], error) {
+		pageOptions := base
+		pageOptions.Offset = base.Offset + request.Offset
+		list, err := c.ListTasks(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[Task]{
+			Items:       list.Data,
+			HasNextPage: list.Offset+len(list.Data) < list.Total,
+		}, nil
+	})
+}
+
+// AllFiles returns a pager over every entry of a directory, fetching pages
+// with ListFiles
+func (c *Client) AllFiles(ctx context.Context, endpointID, path string, options *ListFileOptions) *core.Pager[FileListItem] {
+	var base ListFileOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[FileListItem], error) {
+		pageOptions := base
+		if request.Marker != "" {
+			pageOptions.Marker = request.Marker
+		}
+		list, err := c.ListFiles(ctx, endpointID, path, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		// Directory listings page by marker only
+		return &core.Page[FileListItem]{
+			Items:       list.Data,
+			HasNextPage: list.HasNextPage && list.Marker != "",
+			NextMarker:  list.Marker,
+		}, nil
+	})
+}
+
+// AllDirectory returns a pager over every entry of a directory, fetching
+// pages with ListDirectory
+func (c *Client) AllDirectory(ctx context.Context, options *ListDirectoryOptions) *core.Pager[FileListItem] {
+	var base ListDirectoryOptions
+	if options != nil {
+		base = *options
+	}
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[FileListItem], error) {
+		pageOptions := base
+		if request.Marker != "" {
+			pageOptions.Marker = request.Marker
+		}
+		list, err := c.ListDirectory(ctx, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[FileListItem]{
+			Items:       list.Data,
+			HasNextPage: list.HasNextPage && list.Marker != "",
+			NextMarker:  list.Marker,
+		}, nil
+	})
+}
+
+// AllSuccessfulTransfers returns a pager over every file a task transferred
+// successfully, fetching pages with ListSuccessfulTransfers
+func (c *Client) AllSuccessfulTransfers(ctx context.Context, taskID string) *core.Pager[SuccessfulTransfer] {
+	return core.NewPager(ctx, func(ctx context.Context, request core.PageRequest) (*core.Page[SuccessfulTransfer], error) {
+		list, err := c.ListSuccessfulTransfers(ctx, taskID, request.Marker)
+		if err != nil {
+			return nil, err
+		}
+		return &core.Page[SuccessfulTransfer]{Items: list.Data, HasNextPage: list.NextMarker != "", NextMarker: list.NextMarker}, nil
+	})
+}
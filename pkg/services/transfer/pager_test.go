@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestAllTasks(t *testing.T) {
+	tasks := []Task{{TaskID: "task-1"}, {TaskID: "task-2"}, {TaskID: "task-3"}}
+	var offsets []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/task_list" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("filter_status"); got != "ACTIVE" {
+			t.Errorf("filter_status = %q, want ACTIVE", got)
+		}
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+
+		// The task list reports offset, limit and total, never has_next_page
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		end := offset + 2
+		if end > len(tasks) {
+			end = len(tasks)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"DATA_TYPE": "task_list",
+			"offset":    offset,
+			"limit":     2,
+			"total":     len(tasks),
+			"data":      tasks[offset:end],
+		})
+	}
+
+	server, client := setupMockServer(handler)
+	defer server.Close()
+
+	got, err := client.AllTasks(context.Background(), &ListTasksOptions{FilterStatus: "ACTIVE", Limit: 2}).Collect()
+	if err != nil {
+		t.Fatalf("AllTasks() error = %v", err)
+	}
+	if len(got) != 3 || got[2].TaskID != "task-3" {
+		t.Errorf("AllTasks() = %+v", got)
+	}
+	if len(offsets) != 2 || offsets[0] != "" || offsets[1] != "2" {
+		t.Errorf("Offsets = %v", offsets)
+	}
+}
+
+func TestAllEndpoints(t *testing.T) {
+	var offsets []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+
+		list := EndpointList{Data: []Endpoint{{ID: "ep-1"}, {ID: "ep-2"}}, HasNextPage: true}
+		if offset == "2" {
+			list = EndpointList{Data: []Endpoint{{ID: "ep-3"}}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(list)
+	}
+
+	server, client := setupMockServer(handler)
+	defer server.Close()
+
+	pager := client.AllEndpoints(context.Background(), &ListEndpointsOptions{Limit: 2})
+	var ids []string
+	for pager.Next() {
+		ids = append(ids, pager.Value().ID)
+	}
+	if pager.Err() != nil || len(ids) != 3 {
+		t.Fatalf("AllEndpoints() = %v, %v", ids, pager.Err())
+	}
+	if len(offsets) != 2 || offsets[0] != "" || offsets[1] != "2" {
+		t.Errorf("Offsets = %v", offsets)
+	}
+}
@@ -0,0 +1,265 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ReadinessStatus describes whether an endpoint can currently accept transfers
+type ReadinessStatus string
+
+// Readiness states reported by CheckEndpointReadiness
+const (
+	// ReadinessReady means the endpoint is connected and the probe listing succeeded
+	ReadinessReady ReadinessStatus = "ready"
+
+	// ReadinessPaused means the endpoint is paused or hibernating
+	ReadinessPaused ReadinessStatus = "paused"
+
+	// ReadinessDisconnected means a Globus Connect endpoint is not connected
+	ReadinessDisconnected ReadinessStatus = "disconnected"
+
+	// ReadinessNeedsConsent means the user must grant a data_access consent
+	ReadinessNeedsConsent ReadinessStatus = "needs_consent"
+
+	// ReadinessPermissionDenied means the user cannot list the probe path
+	ReadinessPermissionDenied ReadinessStatus = "permission_denied"
+
+	// ReadinessError means the endpoint could not be checked for another reason
+	ReadinessError ReadinessStatus = "error"
+)
+
+// DefaultReadinessProbePath is listed when no probe path is configured and
+// the endpoint has no default directory
+const DefaultReadinessProbePath = "/~/"
+
+// ReadinessOptions contains options for endpoint readiness checks
+type ReadinessOptions struct {
+	// ProbePath is the path listed on every endpoint. When empty, the
+	// endpoint's default directory or DefaultReadinessProbePath is used.
+	ProbePath string
+
+	// ProbePaths overrides ProbePath for individual endpoint IDs
+	ProbePaths map[string]string
+
+	// SkipProbe only inspects the endpoint document and skips the listing
+	SkipProbe bool
+}
+
+// EndpointReadiness is the readiness result for a single endpoint
+type EndpointReadiness struct {
+	EndpointID       string          `json:"endpoint_id"`
+	DisplayName      string          `json:"display_name,omitempty"`
+	Status           ReadinessStatus `json:"status"`
+	Reason           string          `json:"reason,omitempty"`
+	ProbePath        string          `json:"probe_path,omitempty"`
+	ErrorCode        string          `json:"error_code,omitempty"`
+	Activated        bool            `json:"activated"`
+	IsGlobusConnect  bool            `json:"is_globus_connect"`
+	GCPConnected     bool            `json:"gcp_connected"`
+	GCPPaused        bool            `json:"gcp_paused"`
+	HibernationState string          `json:"hibernation_state,omitempty"`
+	DeactivationTime *time.Time      `json:"deactivation_time,omitempty"`
+	CheckedAt        time.Time       `json:"checked_at"`
+}
+
+// IsReady returns true if the endpoint can accept transfers
+func (r *EndpointReadiness) IsReady() bool {
+	return r.Status == ReadinessReady
+}
+
+// ReadinessReport is the result of checking a set of endpoints
+type ReadinessReport struct {
+	CheckedAt time.Time           `json:"checked_at"`
+	AllReady  bool                `json:"all_ready"`
+	Endpoints []EndpointReadiness `json:"endpoints"`
+}
+
+// NotReady returns the endpoints that are not ready
+func (r *ReadinessReport) NotReady() []EndpointReadiness {
+	var notReady []EndpointReadiness
+	for _, endpoint := range r.Endpoints {
+		if !endpoint.IsReady() {
+			notReady = append(notReady, endpoint)
+		}
+	}
+	return notReady
+}
+
+// Get returns the readiness result for an endpoint ID
+func (r *ReadinessReport) Get(endpointID string) (*EndpointReadiness, bool) {
+	for i := range r.Endpoints {
+		if r.Endpoints[i].EndpointID == endpointID {
+			return &r.Endpoints[i], true
+		}
+	}
+	return nil, false
+}
+
+// JSON returns the report encoded as indented JSON
+func (r *ReadinessReport) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// CheckEndpointReadiness checks whether each endpoint is able to take part in
+// a transfer. It fetches every endpoint, inspects its connection state and
+// lists a probe path to detect consent and permission problems.
+func (c *Client) CheckEndpointReadiness(ctx context.Context, endpointIDs ...string) (*ReadinessReport, error) {
+	return c.CheckEndpointReadinessWithOptions(ctx, nil, endpointIDs...)
+}
+
+// CheckEndpointReadinessWithOptions checks endpoint readiness with custom options.
+// Problems with individual endpoints are recorded in the report; an error is
+// only returned if no endpoints were given or the context is done.
+func (c *Client) CheckEndpointReadinessWithOptions(
+	ctx context.Context,
+	options *ReadinessOptions,
+	endpointIDs ...string,
+) (*ReadinessReport, error) {
+	if len(endpointIDs) == 0 {
+		return nil, fmt.Errorf("at least one endpoint ID is required")
+	}
+
+	if options == nil {
+		options = &ReadinessOptions{}
+	}
+
+	report := &ReadinessReport{
+		CheckedAt: time.Now(),
+		AllReady:  true,
+		Endpoints: make([]EndpointReadiness, 0, len(endpointIDs)),
+	}
+
+	for _, endpointID := range endpointIDs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		result := c.checkEndpoint(ctx, endpointID, options)
+		if !result.IsReady() {
+			report.AllReady = false
+		}
+		report.Endpoints = append(report.Endpoints, result)
+	}
+
+	return report, nil
+}
+
+// checkEndpoint determines the readiness of a single endpoint
+func (c *Client) checkEndpoint(ctx context.Context, endpointID string, options *ReadinessOptions) EndpointReadiness {
+	result := EndpointReadiness{
+		EndpointID: endpointID,
+		CheckedAt:  time.Now(),
+	}
+
+	endpoint, err := c.GetEndpoint(ctx, endpointID)
+	if err != nil {
+		classifyReadinessError(&result, err)
+		return result
+	}
+
+	result.DisplayName = endpoint.DisplayName
+	result.Activated = endpoint.Activated
+	result.IsGlobusConnect = endpoint.IsGlobusConnect
+	result.GCPConnected = endpoint.GCPConnected
+	result.GCPPaused = endpoint.GCPPaused
+	result.HibernationState = endpoint.HibernationState
+	result.DeactivationTime = endpoint.DeactivationTime
+
+	if endpoint.GCPPaused {
+		result.Status = ReadinessPaused
+		result.Reason = "Globus Connect Personal endpoint is paused"
+		return result
+	}
+
+	if isHibernating(endpoint.HibernationState) {
+		result.Status = ReadinessPaused
+		result.Reason = fmt.Sprintf("endpoint is hibernating (%s)", endpoint.HibernationState)
+		return result
+	}
+
+	if endpoint.IsGlobusConnect && !endpoint.GCPConnected {
+		result.Status = ReadinessDisconnected
+		result.Reason = "Globus Connect Personal endpoint is not connected"
+		return result
+	}
+
+	if options.SkipProbe {
+		result.Status = ReadinessReady
+		return result
+	}
+
+	result.ProbePath = probePathFor(endpointID, endpoint, options)
+	_, err = c.ListFiles(ctx, endpointID, result.ProbePath, &ListFileOptions{Limit: 1})
+	if err != nil {
+		classifyReadinessError(&result, err)
+		return result
+	}
+
+	result.Status = ReadinessReady
+	return result
+}
+
+// probePathFor returns the path to list when probing an endpoint
+func probePathFor(endpointID string, endpoint *Endpoint, options *ReadinessOptions) string {
+	if p, ok := options.ProbePaths[endpointID]; ok && p != "" {
+		return p
+	}
+	if options.ProbePath != "" {
+		return options.ProbePath
+	}
+	if endpoint.DefaultDirectory != "" {
+		return endpoint.DefaultDirectory
+	}
+	return DefaultReadinessProbePath
+}
+
+// isHibernating reports whether a hibernation state indicates the endpoint is unavailable
+func isHibernating(state string) bool {
+	switch strings.ToLower(state) {
+	case "", "none", "ok", "active":
+		return false
+	default:
+		return true
+	}
+}
+
+// classifyReadinessError maps an API error to a readiness status
+func classifyReadinessError(result *EndpointReadiness, err error) {
+	result.Status = ReadinessError
+	result.Reason = err.Error()
+
+	transferErr, ok := asTransferError(err)
+	if !ok {
+		if IsPermissionDenied(err) {
+			result.Status = ReadinessPermissionDenied
+		}
+		return
+	}
+
+	result.ErrorCode = transferErr.Code
+	if transferErr.Message != "" {
+		result.Reason = transferErr.Message
+	}
+
+	switch {
+	case transferErr.Code == ErrCodeConsentRequired:
+		result.Status = ReadinessNeedsConsent
+	case strings.Contains(transferErr.Code, ErrCodePermissionDenied),
+		transferErr.StatusCode == http.StatusForbidden:
+		result.Status = ReadinessPermissionDenied
+	case strings.Contains(transferErr.Code, "GCDisconnected"),
+		transferErr.Code == ErrCodeEndpointError,
+		transferErr.Code == ErrCodeServiceUnavailable,
+		transferErr.StatusCode == http.StatusBadGateway,
+		transferErr.StatusCode == http.StatusServiceUnavailable,
+		transferErr.StatusCode == http.StatusGatewayTimeout:
+		result.Status = ReadinessDisconnected
+	}
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCheckEndpointReadiness(t *testing.T) {
+	endpoints := map[string]Endpoint{
+		"ready-ep":    {ID: "ready-ep", DisplayName: "Ready", Activated: true, DefaultDirectory: "/data/"},
+		"paused-ep":   {ID: "paused-ep", IsGlobusConnect: true, GCPConnected: true, GCPPaused: true},
+		"offline-ep":  {ID: "offline-ep", IsGlobusConnect: true, GCPConnected: false},
+		"consent-ep":  {ID: "consent-ep"},
+		"denied-ep":   {ID: "denied-ep"},
+		"hibernating": {ID: "hibernating", HibernationState: "hibernating"},
+	}
+
+	var probedPath string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(parts) == 2 && parts[0] == "endpoint" {
+			endpoint, ok := endpoints[parts[1]]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				json.NewEncoder(w).Encode(map[string]string{
+					"code":    "EndpointNotFound",
+					"message": "No such endpoint",
+				})
+				return
+			}
+			json.NewEncoder(w).Encode(endpoint)
+			return
+		}
+
+		if len(parts) == 4 && parts[3] == "ls" {
+			switch parts[2] {
+			case "consent-ep":
+				w.WriteHeader(http.StatusForbidden)
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"code":            "ConsentRequired",
+					"message":         "Missing required data_access consent",
+					"required_scopes": []string{"urn:globus:auth:scope:transfer.api.globus.org:all[*https://auth.globus.org/scopes/consent-ep/data_access]"},
+				})
+			case "denied-ep":
+				w.WriteHeader(http.StatusForbidden)
+				json.NewEncoder(w).Encode(map[string]string{
+					"code":    "PermissionDenied",
+					"message": "No permission to list /~/",
+				})
+			default:
+				probedPath = r.URL.Query().Get("path")
+				json.NewEncoder(w).Encode(FileList{Path: probedPath})
+			}
+			return
+		}
+
+		t.Errorf("Unexpected request to %s", r.URL.Path)
+		w.WriteHeader(http.StatusBadRequest)
+	}
+
+	server, client := setupMockServer(handler)
+	defer server.Close()
+
+	report, err := client.CheckEndpointReadiness(context.Background(),
+		"ready-ep", "paused-ep", "offline-ep", "consent-ep", "denied-ep", "hibernating", "missing-ep")
+	if err != nil {
+		t.Fatalf("CheckEndpointReadiness() error = %v", err)
+	}
+
+	if report.AllReady {
+		t.Error("CheckEndpointReadiness() AllReady = true, want false")
+	}
+	if len(report.Endpoints) != 7 {
+		t.Fatalf("CheckEndpointReadiness() returned %d results, want 7", len(report.Endpoints))
+	}
+	if probedPath != "/data/" {
+		t.Errorf("Probe path = %q, want endpoint default directory %q", probedPath, "/data/")
+	}
+
+	expected := map[string]ReadinessStatus{
+		"ready-ep":    ReadinessReady,
+		"paused-ep":   ReadinessPaused,
+		"offline-ep":  ReadinessDisconnected,
+		"consent-ep":  ReadinessNeedsConsent,
+		"denied-ep":   ReadinessPermissionDenied,
+		"hibernating": ReadinessPaused,
+		"missing-ep":  ReadinessError,
+	}
+	for id, want := range expected {
+		result, ok := report.Get(id)
+		if !ok {
+			t.Errorf("Report missing endpoint %s", id)
+			continue
+		}
+		if result.Status != want {
+			t.Errorf("Endpoint %s status = %s, want %s (reason: %s)", id, result.Status, want, result.Reason)
+		}
+	}
+
+	if notReady := report.NotReady(); len(notReady) != 6 {
+		t.Errorf("NotReady() returned %d endpoints, want 6", len(notReady))
+	}
+
+	data, err := report.JSON()
+	if err != nil {
+		t.Fatalf("JSON() error = %v", err)
+	}
+	var decoded ReadinessReport
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to decode report JSON: %v", err)
+	}
+	if decoded.Endpoints[3].ErrorCode != ErrCodeConsentRequired {
+		t.Errorf("Decoded error code = %q, want %q", decoded.Endpoints[3].ErrorCode, ErrCodeConsentRequired)
+	}
+}
+
+func TestCheckEndpointReadinessProbeOptions(t *testing.T) {
+	var probedPath string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/ls") {
+			probedPath = r.URL.Query().Get("path")
+			json.NewEncoder(w).Encode(FileList{})
+			return
+		}
+		json.NewEncoder(w).Encode(Endpoint{ID: "ep1", DefaultDirectory: "/home/"})
+	}
+
+	server, client := setupMockServer(handler)
+	defer server.Close()
+
+	_, err := client.CheckEndpointReadinessWithOptions(context.Background(), &ReadinessOptions{
+		ProbePath:  "/shared/",
+		ProbePaths: map[string]string{"ep1": "/projects/nightly/"},
+	}, "ep1")
+	if err != nil {
+		t.Fatalf("CheckEndpointReadinessWithOptions() error = %v", err)
+	}
+	if probedPath != "/projects/nightly/" {
+		t.Errorf("Probe path = %q, want %q", probedPath, "/projects/nightly/")
+	}
+
+	_, err = client.CheckEndpointReadiness(context.Background())
+	if err == nil {
+		t.Error("CheckEndpointReadiness() with no endpoints should return error")
+	}
+}
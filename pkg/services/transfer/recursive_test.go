@@ -153,7 +153,7 @@ func TestSubmitRecursiveTransfer(t *testing.T) {
 		}
 
 		// Check if this is the directory listing request
-		if r.URL.Path == "/operation/endpoint/source-endpoint/ls" && r.URL.Query().Get("path") == "/source" {
+		if r.URL.Path == "/operation/endpoint/6f1e2a3b-0000-4000-8000-00000000a001/ls" && r.URL.Query().Get("path") == "/source" {
 			// Return a mock directory listing
 			fileList := FileList{
 				Data: []FileListItem{
@@ -171,7 +171,7 @@ func TestSubmitRecursiveTransfer(t *testing.T) {
 		}
 
 		// Check if this is the first directory listing without path (fallback)
-		if r.URL.Path == "/operation/endpoint/source-endpoint/ls" && r.URL.Query().Get("path") == "" {
+		if r.URL.Path == "/operation/endpoint/6f1e2a3b-0000-4000-8000-00000000a001/ls" && r.URL.Query().Get("path") == "" {
 			// Return a mock directory listing
 			fileList := FileList{
 				Data: []FileListItem{
@@ -189,7 +189,7 @@ func TestSubmitRecursiveTransfer(t *testing.T) {
 		}
 
 		// Check if this is the subdirectory listing request
-		if r.URL.Path == "/operation/endpoint/source-endpoint/ls" && r.URL.Query().Get("path") == "/source/subdir1" {
+		if r.URL.Path == "/operation/endpoint/6f1e2a3b-0000-4000-8000-00000000a001/ls" && r.URL.Query().Get("path") == "/source/subdir1" {
 			// Return a mock subdirectory listing
 			fileList := FileList{
 				Data: []FileListItem{
@@ -245,8 +245,8 @@ func TestSubmitRecursiveTransfer(t *testing.T) {
 	// Submit the recursive transfer
 	result, err := client.SubmitRecursiveTransfer(
 		context.Background(),
-		"source-endpoint", "/source",
-		"destination-endpoint", "/destination",
+		"6f1e2a3b-0000-4000-8000-00000000a001", "/source",
+		"6f1e2a3b-0000-4000-8000-00000000b002", "/destination",
 		options,
 	)
 
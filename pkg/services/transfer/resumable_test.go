@@ -25,7 +25,7 @@ func TestCreateResumableTransfer(t *testing.T) {
 
 	// Create a mock server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method == http.MethodGet && r.URL.Path == "/operation/endpoint/src-endpoint/ls" {
+		if r.Method == http.MethodGet && r.URL.Path == "/operation/endpoint/6f1e2a3b-0000-4000-8000-00000000a001/ls" {
 			// Return a sample file listing
 			files := FileList{
 				Data: []FileListItem{
@@ -44,7 +44,7 @@ func TestCreateResumableTransfer(t *testing.T) {
 						LastModified: time.Now().Format(time.RFC3339),
 					},
 				},
-				EndpointID:  "src-endpoint",
+				EndpointID:  "6f1e2a3b-0000-4000-8000-00000000a001",
 				Path:        "/source",
 				HasNextPage: false,
 			}
@@ -70,8 +70,8 @@ func TestCreateResumableTransfer(t *testing.T) {
 
 	checkpointID, err := client.CreateResumableTransfer(
 		context.Background(),
-		"src-endpoint", "/source",
-		"dest-endpoint", "/destination",
+		"6f1e2a3b-0000-4000-8000-00000000a001", "/source",
+		"6f1e2a3b-0000-4000-8000-00000000b002", "/destination",
 		options,
 	)
 
@@ -95,12 +95,12 @@ func TestCreateResumableTransfer(t *testing.T) {
 	}
 
 	// Verify checkpoint state
-	if state.TaskInfo.SourceEndpointID != "src-endpoint" {
-		t.Errorf("Expected source endpoint 'src-endpoint', got '%s'", state.TaskInfo.SourceEndpointID)
+	if state.TaskInfo.SourceEndpointID != "6f1e2a3b-0000-4000-8000-00000000a001" {
+		t.Errorf("Expected source endpoint '6f1e2a3b-0000-4000-8000-00000000a001', got '%s'", state.TaskInfo.SourceEndpointID)
 	}
 
-	if state.TaskInfo.DestinationEndpointID != "dest-endpoint" {
-		t.Errorf("Expected destination endpoint 'dest-endpoint', got '%s'", state.TaskInfo.DestinationEndpointID)
+	if state.TaskInfo.DestinationEndpointID != "6f1e2a3b-0000-4000-8000-00000000b002" {
+		t.Errorf("Expected destination endpoint '6f1e2a3b-0000-4000-8000-00000000b002', got '%s'", state.TaskInfo.DestinationEndpointID)
 	}
 
 	if len(state.PendingItems) != 2 {
@@ -138,8 +138,8 @@ func TestCheckpointStorage(t *testing.T) {
 	state := &CheckpointState{
 		CheckpointID: "test-checkpoint",
 	}
-	state.TaskInfo.SourceEndpointID = "src-endpoint"
-	state.TaskInfo.DestinationEndpointID = "dest-endpoint"
+	state.TaskInfo.SourceEndpointID = "6f1e2a3b-0000-4000-8000-00000000a001"
+	state.TaskInfo.DestinationEndpointID = "6f1e2a3b-0000-4000-8000-00000000b002"
 	state.TaskInfo.SourceBasePath = "/source"
 	state.TaskInfo.DestinationBasePath = "/destination"
 	state.TaskInfo.Label = "Test Transfer"
@@ -192,8 +192,8 @@ func TestCheckpointStorage(t *testing.T) {
 		t.Errorf("Expected checkpoint ID 'test-checkpoint', got '%s'", loadedState.CheckpointID)
 	}
 
-	if loadedState.TaskInfo.SourceEndpointID != "src-endpoint" {
-		t.Errorf("Expected source endpoint 'src-endpoint', got '%s'", loadedState.TaskInfo.SourceEndpointID)
+	if loadedState.TaskInfo.SourceEndpointID != "6f1e2a3b-0000-4000-8000-00000000a001" {
+		t.Errorf("Expected source endpoint '6f1e2a3b-0000-4000-8000-00000000a001', got '%s'", loadedState.TaskInfo.SourceEndpointID)
 	}
 
 	if len(loadedState.PendingItems) != 2 {
@@ -0,0 +1,658 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ScheduledTransferState is the lifecycle state of a scheduled transfer
+type ScheduledTransferState string
+
+// States of a scheduled transfer
+const (
+	// ScheduledQueued means the transfer is waiting for a free slot
+	ScheduledQueued ScheduledTransferState = "queued"
+
+	// ScheduledSubmitting means the transfer holds a slot while it is being
+	// submitted
+	ScheduledSubmitting ScheduledTransferState = "submitting"
+
+	// ScheduledActive means the transfer was submitted and the task has not finished
+	ScheduledActive ScheduledTransferState = "active"
+
+	// ScheduledSucceeded means the task finished successfully
+	ScheduledSucceeded ScheduledTransferState = "succeeded"
+
+	// ScheduledFailed means the task failed or could not be submitted
+	ScheduledFailed ScheduledTransferState = "failed"
+
+	// ScheduledCanceled means the transfer was canceled through the scheduler
+	ScheduledCanceled ScheduledTransferState = "canceled"
+)
+
+// ErrScheduledTransferNotFound is returned when a scheduled transfer ID is unknown
+var ErrScheduledTransferNotFound = errors.New("scheduled transfer not found")
+
+// ScheduledTransfer is a transfer request tracked by a TransferScheduler
+type ScheduledTransfer struct {
+	ID          string                 `json:"id"`
+	Priority    int                    `json:"priority"`
+	Project     string                 `json:"project,omitempty"`
+	Request     *TransferTaskRequest   `json:"request"`
+	State       ScheduledTransferState `json:"state"`
+	TaskID      string                 `json:"task_id,omitempty"`
+	TaskStatus  string                 `json:"task_status,omitempty"`
+	Attempts    int                    `json:"attempts"`
+	LastError   string                 `json:"last_error,omitempty"`
+	EnqueuedAt  time.Time              `json:"enqueued_at"`
+	SubmittedAt *time.Time             `json:"submitted_at,omitempty"`
+	CompletedAt *time.Time             `json:"completed_at,omitempty"`
+}
+
+// IsFinished returns true if the transfer has reached a terminal state
+func (t *ScheduledTransfer) IsFinished() bool {
+	switch t.State {
+	case ScheduledSucceeded, ScheduledFailed, ScheduledCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// endpointPair returns the key used for per-endpoint-pair concurrency limits
+func (t *ScheduledTransfer) endpointPair() string {
+	return t.Request.SourceEndpointID + "->" + t.Request.DestinationEndpointID
+}
+
+// SchedulerOptions contains options for a TransferScheduler
+type SchedulerOptions struct {
+	// MaxActivePerEndpointPair caps concurrently active tasks between one
+	// source and destination endpoint
+	MaxActivePerEndpointPair int
+
+	// MaxActive caps the total number of active tasks; 0 means no limit
+	MaxActive int
+
+	// PollInterval is how often Run polls active tasks and submits queued ones
+	PollInterval time.Duration
+
+	// QueueFile is the path where the queue is persisted. When empty the
+	// queue is kept in memory only.
+	QueueFile string
+
+	// MaxSubmitAttempts is the number of times a retryable submission error
+	// is tolerated before the transfer is marked failed
+	MaxSubmitAttempts int
+
+	// OnStateChange is called after a scheduled transfer changes state.
+	// It is called without the scheduler lock held.
+	OnStateChange func(transfer ScheduledTransfer)
+}
+
+// DefaultSchedulerOptions returns default options for a TransferScheduler
+func DefaultSchedulerOptions() *SchedulerOptions {
+	return &SchedulerOptions{
+		MaxActivePerEndpointPair: 2,
+		MaxActive:                0,
+		PollInterval:             30 * time.Second,
+		MaxSubmitAttempts:        3,
+	}
+}
+
+// schedulerState is the on-disk representation of the scheduler queue
+type schedulerState struct {
+	Version   int                  `json:"version"`
+	UpdatedAt time.Time            `json:"updated_at"`
+	Transfers []*ScheduledTransfer `json:"transfers"`
+}
+
+// schedulerStateVersion is the current queue file format version
+const schedulerStateVersion = 1
+
+// TransferScheduler queues transfer requests on the client side and submits
+// them as concurrency slots free up. Higher priorities are submitted first;
+// among equal priorities the project with the fewest active tasks goes first,
+// so one project cannot monopolise a shared endpoint pair.
+type TransferScheduler struct {
+	client  *Client
+	options SchedulerOptions
+
+	mu        sync.Mutex
+	transfers []*ScheduledTransfer
+}
+
+// NewTransferScheduler creates a scheduler that submits through client.
+// If options.QueueFile exists, the persisted queue is loaded.
+func NewTransferScheduler(client *Client, options *SchedulerOptions) (*TransferScheduler, error) {
+	if client == nil {
+		return nil, fmt.Errorf("transfer client is required")
+	}
+
+	defaults := DefaultSchedulerOptions()
+	if options == nil {
+		options = defaults
+	}
+	opts := *options
+	if opts.MaxActivePerEndpointPair <= 0 {
+		opts.MaxActivePerEndpointPair = defaults.MaxActivePerEndpointPair
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaults.PollInterval
+	}
+	if opts.MaxSubmitAttempts <= 0 {
+		opts.MaxSubmitAttempts = defaults.MaxSubmitAttempts
+	}
+
+	scheduler := &TransferScheduler{
+		client:  client,
+		options: opts,
+	}
+
+	if opts.QueueFile != "" {
+		if err := scheduler.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	return scheduler, nil
+}
+
+// Enqueue adds a transfer request to the queue and returns its scheduled entry
+func (s *TransferScheduler) Enqueue(request *TransferTaskRequest, priority int, project string) (*ScheduledTransfer, error) {
+	if request == nil {
+		return nil, fmt.Errorf("transfer task request is required")
+	}
+
+	requestCopy := *request
+	requestCopy.Items = append([]TransferItem(nil), request.Items...)
+	if requestCopy.DataType == "" {
+		requestCopy.DataType = "transfer"
+	}
+	for i := range requestCopy.Items {
+		if requestCopy.Items[i].DataType == "" {
+			requestCopy.Items[i].DataType = "transfer_item"
+		}
+	}
+
+	// Reject requests that could never be submitted
+	if err := requestCopy.Validate(); err != nil {
+		return nil, err
+	}
+
+	id, err := generateCheckpointID()
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := &ScheduledTransfer{
+		ID:         id,
+		Priority:   priority,
+		Project:    project,
+		Request:    &requestCopy,
+		State:      ScheduledQueued,
+		EnqueuedAt: time.Now(),
+	}
+
+	s.mu.Lock()
+	s.transfers = append(s.transfers, transfer)
+	err = s.saveLocked()
+	snapshot := *transfer
+	s.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// Get returns a copy of a scheduled transfer
+func (s *TransferScheduler) Get(id string) (ScheduledTransfer, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if transfer := s.findLocked(id); transfer != nil {
+		return *transfer, true
+	}
+	return ScheduledTransfer{}, false
+}
+
+// List returns copies of all scheduled transfers in enqueue order
+func (s *TransferScheduler) List() []ScheduledTransfer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]ScheduledTransfer, 0, len(s.transfers))
+	for _, transfer := range s.transfers {
+		result = append(result, *transfer)
+	}
+	return result
+}
+
+// Pending returns the number of queued, submitting and active transfers
+func (s *TransferScheduler) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pending := 0
+	for _, transfer := range s.transfers {
+		if !transfer.IsFinished() {
+			pending++
+		}
+	}
+	return pending
+}
+
+// Cancel cancels a scheduled transfer. Queued transfers are removed from the
+// queue; active transfers are canceled through the Transfer API. A transfer
+// that is being submitted is marked canceled at once, and its task is
+// canceled as soon as the submission returns.
+func (s *TransferScheduler) Cancel(ctx context.Context, id string) error {
+	s.mu.Lock()
+	transfer := s.findLocked(id)
+	if transfer == nil {
+		s.mu.Unlock()
+		return ErrScheduledTransferNotFound
+	}
+	state, taskID := transfer.State, transfer.TaskID
+	s.mu.Unlock()
+
+	switch state {
+	case ScheduledActive:
+		if _, err := s.client.CancelTask(ctx, taskID); err != nil && !IsTaskCompleted(err) {
+			return fmt.Errorf("failed to cancel task %s: %w", taskID, err)
+		}
+	case ScheduledQueued, ScheduledSubmitting:
+	default:
+		return nil
+	}
+
+	return s.update(id, func(t *ScheduledTransfer) {
+		if t.IsFinished() {
+			return
+		}
+		now := time.Now()
+		t.State = ScheduledCanceled
+		t.CompletedAt = &now
+	})
+}
+
+// Prune removes finished transfers from the queue
+func (s *TransferScheduler) Prune() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.transfers[:0]
+	for _, transfer := range s.transfers {
+		if !transfer.IsFinished() {
+			kept = append(kept, transfer)
+		}
+	}
+	s.transfers = kept
+
+	return s.saveLocked()
+}
+
+// Run polls and submits until the context is canceled
+func (s *TransferScheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Tick(ctx); err != nil && ctx.Err() == nil {
+			s.client.Client.Logger.Error("Transfer scheduler tick failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick polls active tasks for completion and then submits queued transfers
+// into any free slots. It is safe to call Tick directly instead of Run, and
+// to call it concurrently; each transfer is submitted by only one caller.
+func (s *TransferScheduler) Tick(ctx context.Context) error {
+	if err := s.pollActive(ctx); err != nil {
+		return err
+	}
+	return s.submitQueued(ctx)
+}
+
+// pollActive refreshes the status of active tasks
+func (s *TransferScheduler) pollActive(ctx context.Context) error {
+	s.mu.Lock()
+	var active []ScheduledTransfer
+	for _, transfer := range s.transfers {
+		if transfer.State == ScheduledActive {
+			active = append(active, *transfer)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, transfer := range active {
+		task, err := s.client.GetTask(ctx, transfer.TaskID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// Leave the transfer active; the next poll will try again
+			continue
+		}
+
+		err = s.update(transfer.ID, func(t *ScheduledTransfer) {
+			t.TaskStatus = task.Status
+			switch task.Status {
+			case "SUCCEEDED":
+				t.State = ScheduledSucceeded
+			case "FAILED":
+				t.State = ScheduledFailed
+				t.LastError = taskFailureMessage(task)
+			case "CANCELED", "CANCELLED":
+				t.State = ScheduledCanceled
+			default:
+				return
+			}
+			completed := time.Now()
+			if task.CompletionTime != nil {
+				completed = *task.CompletionTime
+			}
+			t.CompletedAt = &completed
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// submitQueued submits queued transfers while slots are available
+func (s *TransferScheduler) submitQueued(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		next := s.nextLocked()
+		if next == nil {
+			s.mu.Unlock()
+			return nil
+		}
+		// Hold the slot while the lock is released so concurrent ticks
+		// neither submit this transfer again nor exceed the limits
+		next.State = ScheduledSubmitting
+		next.Attempts++
+		request := *next.Request
+		id := next.ID
+		s.mu.Unlock()
+
+		// Reserve a submission ID before submitting and persist it, so a
+		// restarted scheduler resubmits idempotently
+		if request.SubmissionID == "" {
+			submissionID, err := s.client.GetSubmissionID(ctx)
+			if err != nil {
+				if updateErr := s.recordSubmitError(id, err); updateErr != nil {
+					return updateErr
+				}
+				return err
+			}
+			request.SubmissionID = submissionID
+			if err := s.update(id, func(t *ScheduledTransfer) {
+				t.Request.SubmissionID = submissionID
+			}); err != nil {
+				return err
+			}
+		}
+
+		response, err := s.client.CreateTransferTask(ctx, &request)
+		if err != nil {
+			if updateErr := s.recordSubmitError(id, err); updateErr != nil {
+				return updateErr
+			}
+			if isInterruptedSubmit(err) {
+				// The transfer is back in the queue with its submission ID
+				return ctx.Err()
+			}
+			if IsRetryableTransferError(err) {
+				// Stop submitting for now; the next tick retries
+				return nil
+			}
+			continue
+		}
+
+		canceled := false
+		if err := s.update(id, func(t *ScheduledTransfer) {
+			now := time.Now()
+			t.TaskID = response.TaskID
+			t.SubmittedAt = &now
+			t.LastError = ""
+			if t.State == ScheduledCanceled {
+				canceled = true
+				return
+			}
+			t.State = ScheduledActive
+		}); err != nil {
+			return err
+		}
+
+		// The transfer was canceled while it was being submitted
+		if canceled {
+			if _, err := s.client.CancelTask(ctx, response.TaskID); err != nil && !IsTaskCompleted(err) {
+				return fmt.Errorf("failed to cancel task %s: %w", response.TaskID, err)
+			}
+		}
+	}
+}
+
+// recordSubmitError records a failed submission attempt. Retryable errors
+// return the transfer to the queue until MaxSubmitAttempts is reached.
+// Interrupted submissions return it to the queue without counting the
+// attempt; the task may have been created, so the transfer keeps its
+// submission ID and the resubmission is deduplicated by Globus Transfer.
+func (s *TransferScheduler) recordSubmitError(id string, err error) error {
+	return s.update(id, func(t *ScheduledTransfer) {
+		t.LastError = err.Error()
+		if t.State != ScheduledSubmitting {
+			return
+		}
+		if isInterruptedSubmit(err) {
+			t.Attempts--
+			t.State = ScheduledQueued
+			return
+		}
+		if !IsRetryableTransferError(err) || t.Attempts >= s.options.MaxSubmitAttempts {
+			now := time.Now()
+			t.State = ScheduledFailed
+			t.CompletedAt = &now
+			return
+		}
+		t.State = ScheduledQueued
+	})
+}
+
+// isInterruptedSubmit reports whether a submission failed before Globus
+// Transfer answered it: the context ended or the request never completed
+func isInterruptedSubmit(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	// *url.Error, returned for transport failures, is a net.Error
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// nextLocked selects the next queued transfer that fits in a free slot.
+// The caller must hold s.mu.
+func (s *TransferScheduler) nextLocked() *ScheduledTransfer {
+	totalActive := 0
+	pairActive := make(map[string]int)
+	projectActive := make(map[string]int)
+	var queued []*ScheduledTransfer
+
+	for _, transfer := range s.transfers {
+		switch transfer.State {
+		case ScheduledActive, ScheduledSubmitting:
+			totalActive++
+			pairActive[transfer.endpointPair()]++
+			projectActive[transfer.Project]++
+		case ScheduledQueued:
+			queued = append(queued, transfer)
+		}
+	}
+
+	if s.options.MaxActive > 0 && totalActive >= s.options.MaxActive {
+		return nil
+	}
+
+	sort.SliceStable(queued, func(i, j int) bool {
+		a, b := queued[i], queued[j]
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		if projectActive[a.Project] != projectActive[b.Project] {
+			return projectActive[a.Project] < projectActive[b.Project]
+		}
+		return a.EnqueuedAt.Before(b.EnqueuedAt)
+	})
+
+	for _, transfer := range queued {
+		if pairActive[transfer.endpointPair()] < s.options.MaxActivePerEndpointPair {
+			return transfer
+		}
+	}
+
+	return nil
+}
+
+// update applies fn to a transfer, persists the queue and fires OnStateChange
+func (s *TransferScheduler) update(id string, fn func(t *ScheduledTransfer)) error {
+	s.mu.Lock()
+	transfer := s.findLocked(id)
+	if transfer == nil {
+		s.mu.Unlock()
+		return ErrScheduledTransferNotFound
+	}
+	previous := transfer.State
+	fn(transfer)
+	snapshot := *transfer
+	err := s.saveLocked()
+	s.mu.Unlock()
+
+	if snapshot.State != previous && s.options.OnStateChange != nil {
+		s.options.OnStateChange(snapshot)
+	}
+
+	return err
+}
+
+// findLocked returns the transfer with the given ID. The caller must hold s.mu.
+func (s *TransferScheduler) findLocked(id string) *ScheduledTransfer {
+	for _, transfer := range s.transfers {
+		if transfer.ID == id {
+			return transfer
+		}
+	}
+	return nil
+}
+
+// saveLocked writes the queue to QueueFile. The caller must hold s.mu.
+func (s *TransferScheduler) saveLocked() error {
+	if s.options.QueueFile == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(&schedulerState{
+		Version:   schedulerStateVersion,
+		UpdatedAt: time.Now(),
+		Transfers: s.transfers,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal scheduler queue: %w", err)
+	}
+
+	dir := filepath.Dir(s.options.QueueFile)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create scheduler queue directory: %w", err)
+	}
+
+	// Write to a temporary file and rename it so a crash never leaves a
+	// partially written queue behind
+	tmp, err := os.CreateTemp(dir, filepath.Base(s.options.QueueFile)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create scheduler queue file: %w", err)
+	}
+	tmpName := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return fmt.Errorf("failed to write scheduler queue file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpName)
+		return fmt.Errorf("failed to write scheduler queue file: %w", err)
+	}
+	if err := os.Rename(tmpName, s.options.QueueFile); err != nil {
+		os.Remove(tmpName)
+		return fmt.Errorf("failed to replace scheduler queue file: %w", err)
+	}
+
+	return nil
+}
+
+// load reads the queue from QueueFile if it exists
+func (s *TransferScheduler) load() error {
+	data, err := os.ReadFile(s.options.QueueFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read scheduler queue file: %w", err)
+	}
+
+	var state schedulerState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("failed to unmarshal scheduler queue: %w", err)
+	}
+	if state.Version > schedulerStateVersion {
+		return fmt.Errorf("unsupported scheduler queue version %d", state.Version)
+	}
+
+	for _, transfer := range state.Transfers {
+		if transfer == nil || transfer.Request == nil {
+			continue
+		}
+		// A submission interrupted by a restart is retried with its
+		// persisted submission ID
+		if transfer.State == ScheduledSubmitting {
+			transfer.State = ScheduledQueued
+		}
+		s.transfers = append(s.transfers, transfer)
+	}
+
+	return nil
+}
+
+// taskFailureMessage returns a human-readable reason for a failed task
+func taskFailureMessage(task *Task) string {
+	if description, ok := task.FatalErrorDetails["description"].(string); ok && description != "" {
+		return description
+	}
+	if code, ok := task.FatalErrorDetails["code"].(string); ok && code != "" {
+		return code
+	}
+	return "task failed"
+}
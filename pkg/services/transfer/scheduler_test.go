@@ -0,0 +1,364 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// mockTaskService is a minimal Transfer task API used by scheduler tests
+type mockTaskService struct {
+	mu        sync.Mutex
+	statuses  map[string]string
+	labels    []string
+	nextID    int
+	submitErr int
+	canceled  []string
+
+	// submitting and release, when set, hold each submission until the
+	// test releases it
+	submitting chan struct{}
+	release    chan struct{}
+}
+
+func (m *mockTaskService) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/transfer" && m.release != nil {
+			m.submitting <- struct{}{}
+			<-m.release
+		}
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/transfer":
+			if m.submitErr > 0 {
+				m.submitErr--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				json.NewEncoder(w).Encode(map[string]string{"code": "ServiceUnavailable", "message": "try later"})
+				return
+			}
+			var request TransferTaskRequest
+			json.NewDecoder(r.Body).Decode(&request)
+			m.nextID++
+			taskID := fmt.Sprintf("task-%d", m.nextID)
+			m.statuses[taskID] = "ACTIVE"
+			m.labels = append(m.labels, request.Label)
+			json.NewEncoder(w).Encode(TaskResponse{TaskID: taskID, Code: "Accepted"})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/cancel"):
+			taskID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/task/"), "/cancel")
+			m.statuses[taskID] = "FAILED"
+			m.canceled = append(m.canceled, taskID)
+			json.NewEncoder(w).Encode(OperationResult{Code: "Canceled"})
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/task/"):
+			taskID := strings.TrimPrefix(r.URL.Path, "/task/")
+			json.NewEncoder(w).Encode(Task{TaskID: taskID, Status: m.statuses[taskID]})
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func (m *mockTaskService) finish(taskID, status string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.statuses[taskID] = status
+}
+
+func (m *mockTaskService) submitted() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.labels...)
+}
+
+func newSchedulerRequest(label, src, dst string) *TransferTaskRequest {
+	return &TransferTaskRequest{
+		Label:                 label,
+		SourceEndpointID:      src,
+		DestinationEndpointID: dst,
+		Items:                 []TransferItem{{SourcePath: "/in/" + label, DestinationPath: "/out/" + label}},
+	}
+}
+
+func TestTransferSchedulerPriorityAndLimits(t *testing.T) {
+	service := &mockTaskService{statuses: make(map[string]string)}
+	server, client := setupMockServer(service.handler(t))
+	defer server.Close()
+
+	var changes []ScheduledTransferState
+	scheduler, err := NewTransferScheduler(client, &SchedulerOptions{
+		MaxActivePerEndpointPair: 1,
+		OnStateChange: func(transfer ScheduledTransfer) {
+			changes = append(changes, transfer.State)
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewTransferScheduler() error = %v", err)
+	}
+
+	low, _ := scheduler.Enqueue(newSchedulerRequest("low", testSourceEndpointID, testDestinationEndpointID), 1, "proj")
+	high, _ := scheduler.Enqueue(newSchedulerRequest("high", testSourceEndpointID, testDestinationEndpointID), 10, "proj")
+	other, _ := scheduler.Enqueue(newSchedulerRequest("other-pair", testSourceEndpointID, testOtherEndpointID), 0, "proj")
+
+	ctx := context.Background()
+	if err := scheduler.Tick(ctx); err != nil {
+		t.Fatalf("Tick() error = %v", err)
+	}
+
+	if got := service.submitted(); len(got) != 2 || got[0] != "high" || got[1] != "other-pair" {
+		t.Fatalf("Submitted after first tick = %v, want [high other-pair]", got)
+	}
+	if st, _ := scheduler.Get(low.ID); st.State != ScheduledQueued {
+		t.Errorf("Low priority state = %s, want queued", st.State)
+	}
+
+	// Completing the high priority task frees the a->b slot
+	highState, _ := scheduler.Get(high.ID)
+	service.finish(highState.TaskID, "SUCCEEDED")
+	if err := scheduler.Tick(ctx); err != nil {
+		t.Fatalf("Tick() error = %v", err)
+	}
+
+	if st, _ := scheduler.Get(high.ID); st.State != ScheduledSucceeded || st.CompletedAt == nil {
+		t.Errorf("High priority state = %s, want succeeded with completion time", st.State)
+	}
+	if st, _ := scheduler.Get(low.ID); st.State != ScheduledActive {
+		t.Errorf("Low priority state = %s, want active", st.State)
+	}
+	if st, _ := scheduler.Get(other.ID); st.State != ScheduledActive {
+		t.Errorf("Other pair state = %s, want active", st.State)
+	}
+	if scheduler.Pending() != 2 {
+		t.Errorf("Pending() = %d, want 2", scheduler.Pending())
+	}
+	if len(changes) != 4 {
+		t.Errorf("OnStateChange called %d times, want 4", len(changes))
+	}
+}
+
+func TestTransferSchedulerProjectFairness(t *testing.T) {
+	service := &mockTaskService{statuses: make(map[string]string)}
+	server, client := setupMockServer(service.handler(t))
+	defer server.Close()
+
+	scheduler, err := NewTransferScheduler(client, &SchedulerOptions{MaxActivePerEndpointPair: 2})
+	if err != nil {
+		t.Fatalf("NewTransferScheduler() error = %v", err)
+	}
+
+	scheduler.Enqueue(newSchedulerRequest("a1", testSourceEndpointID, testDestinationEndpointID), 0, "a")
+	scheduler.Enqueue(newSchedulerRequest("a2", testSourceEndpointID, testDestinationEndpointID), 0, "a")
+	scheduler.Enqueue(newSchedulerRequest("b1", testSourceEndpointID, testDestinationEndpointID), 0, "b")
+
+	if err := scheduler.Tick(context.Background()); err != nil {
+		t.Fatalf("Tick() error = %v", err)
+	}
+
+	if got := service.submitted(); len(got) != 2 || got[0] != "a1" || got[1] != "b1" {
+		t.Errorf("Submitted = %v, want [a1 b1]", got)
+	}
+}
+
+func TestTransferSchedulerRetryAndPersistence(t *testing.T) {
+	service := &mockTaskService{statuses: make(map[string]string), submitErr: 1}
+	server, client := setupMockServer(service.handler(t))
+	defer server.Close()
+
+	queueFile := filepath.Join(t.TempDir(), "queue", "transfers.json")
+	scheduler, err := NewTransferScheduler(client, &SchedulerOptions{QueueFile: queueFile})
+	if err != nil {
+		t.Fatalf("NewTransferScheduler() error = %v", err)
+	}
+
+	queued, err := scheduler.Enqueue(newSchedulerRequest("nightly", testSourceEndpointID, testDestinationEndpointID), 5, "proj")
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	// First submission hits a retryable error and stays queued
+	ctx := context.Background()
+	if err := scheduler.Tick(ctx); err != nil {
+		t.Fatalf("Tick() error = %v", err)
+	}
+	state, _ := scheduler.Get(queued.ID)
+	if state.State != ScheduledQueued || state.Attempts != 1 || state.LastError == "" {
+		t.Fatalf("State after retryable error = %+v", state)
+	}
+	if state.Request.SubmissionID == "" {
+		t.Error("Submission ID was not reserved before submitting")
+	}
+
+	// A new scheduler picks up the persisted queue and submits it
+	restored, err := NewTransferScheduler(client, &SchedulerOptions{QueueFile: queueFile})
+	if err != nil {
+		t.Fatalf("NewTransferScheduler() restore error = %v", err)
+	}
+	if err := restored.Tick(ctx); err != nil {
+		t.Fatalf("Tick() error = %v", err)
+	}
+	state, ok := restored.Get(queued.ID)
+	if !ok || state.State != ScheduledActive || state.TaskID == "" {
+		t.Fatalf("Restored state = %+v", state)
+	}
+
+	if err := restored.Cancel(ctx, "missing"); err != ErrScheduledTransferNotFound {
+		t.Errorf("Cancel(missing) error = %v, want ErrScheduledTransferNotFound", err)
+	}
+
+	service.finish(state.TaskID, "FAILED")
+	if err := restored.Tick(ctx); err != nil {
+		t.Fatalf("Tick() error = %v", err)
+	}
+	if err := restored.Prune(); err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+	if len(restored.List()) != 0 {
+		t.Errorf("List() after Prune = %d entries, want 0", len(restored.List()))
+	}
+}
+
+func TestTransferSchedulerConcurrentTicks(t *testing.T) {
+	service := &mockTaskService{statuses: make(map[string]string)}
+	server, client := setupMockServer(service.handler(t))
+	defer server.Close()
+
+	scheduler, err := NewTransferScheduler(client, &SchedulerOptions{MaxActivePerEndpointPair: 1})
+	if err != nil {
+		t.Fatalf("NewTransferScheduler() error = %v", err)
+	}
+	for _, label := range []string{"first", "second", "third"} {
+		if _, err := scheduler.Enqueue(newSchedulerRequest(label, testSourceEndpointID, testDestinationEndpointID), 0, "proj"); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := scheduler.Tick(context.Background()); err != nil {
+				t.Errorf("Tick() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := service.submitted(); len(got) != 1 || got[0] != "first" {
+		t.Errorf("Submitted = %v, want [first]", got)
+	}
+	if scheduler.Pending() != 3 {
+		t.Errorf("Pending() = %d, want 3", scheduler.Pending())
+	}
+}
+
+func TestTransferSchedulerCancelWhileSubmitting(t *testing.T) {
+	service := &mockTaskService{
+		statuses:   make(map[string]string),
+		submitting: make(chan struct{}),
+		release:    make(chan struct{}),
+	}
+	server, client := setupMockServer(service.handler(t))
+	defer server.Close()
+
+	scheduler, err := NewTransferScheduler(client, nil)
+	if err != nil {
+		t.Fatalf("NewTransferScheduler() error = %v", err)
+	}
+	queued, err := scheduler.Enqueue(newSchedulerRequest("nightly", testSourceEndpointID, testDestinationEndpointID), 0, "proj")
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	ctx := context.Background()
+	done := make(chan error, 1)
+	go func() {
+		done <- scheduler.Tick(ctx)
+	}()
+
+	<-service.submitting
+	if state, _ := scheduler.Get(queued.ID); state.State != ScheduledSubmitting {
+		t.Errorf("State during submission = %s, want submitting", state.State)
+	}
+	if err := scheduler.Cancel(ctx, queued.ID); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	close(service.release)
+	if err := <-done; err != nil {
+		t.Fatalf("Tick() error = %v", err)
+	}
+
+	state, _ := scheduler.Get(queued.ID)
+	if state.State != ScheduledCanceled || state.TaskID == "" {
+		t.Fatalf("State after submission = %+v, want canceled with a task", state)
+	}
+	service.mu.Lock()
+	canceled := append([]string(nil), service.canceled...)
+	service.mu.Unlock()
+	if len(canceled) != 1 || canceled[0] != state.TaskID {
+		t.Errorf("Canceled tasks = %v, want [%s]", canceled, state.TaskID)
+	}
+}
+
+func TestTransferSchedulerInterruptedSubmit(t *testing.T) {
+	service := &mockTaskService{
+		statuses:   make(map[string]string),
+		submitting: make(chan struct{}),
+		release:    make(chan struct{}),
+	}
+	server, client := setupMockServer(service.handler(t))
+	defer server.Close()
+	defer close(service.release)
+
+	queueFile := filepath.Join(t.TempDir(), "transfers.json")
+	scheduler, err := NewTransferScheduler(client, &SchedulerOptions{QueueFile: queueFile})
+	if err != nil {
+		t.Fatalf("NewTransferScheduler() error = %v", err)
+	}
+	queued, err := scheduler.Enqueue(newSchedulerRequest("nightly", testSourceEndpointID, testDestinationEndpointID), 0, "proj")
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- scheduler.Tick(ctx)
+	}()
+
+	// Shut down while the submission is in flight
+	<-service.submitting
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Tick() error = %v, want context.Canceled", err)
+	}
+
+	state, _ := scheduler.Get(queued.ID)
+	if state.State != ScheduledQueued || state.Attempts != 0 {
+		t.Fatalf("State after interrupted submission = %+v, want queued without an attempt", state)
+	}
+	if state.Request.SubmissionID == "" {
+		t.Fatal("Interrupted transfer lost its submission ID")
+	}
+
+	// The persisted queue keeps the transfer and its submission ID
+	restarted, err := NewTransferScheduler(client, &SchedulerOptions{QueueFile: queueFile})
+	if err != nil {
+		t.Fatalf("NewTransferScheduler() error = %v", err)
+	}
+	reloaded, ok := restarted.Get(queued.ID)
+	if !ok || reloaded.State != ScheduledQueued || reloaded.Request.SubmissionID != state.Request.SubmissionID {
+		t.Errorf("Reloaded transfer = %+v, want queued with submission ID %s", reloaded, state.Request.SubmissionID)
+	}
+}
@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package transfer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxItemsPerTask is the largest number of items accepted in a single task
+// request. Larger jobs should be split across several tasks.
+const MaxItemsPerTask = 100000
+
+// ErrInvalidRequest is matched by errors.Is for any request validation failure
+var ErrInvalidRequest = errors.New("invalid request")
+
+// ValidationError describes a single invalid field in a task request.
+// Field uses the JSON field names of the request, e.g. "DATA[2].source_path".
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+// Error returns a string representation of the error
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors is returned by Validate when one or more fields are invalid
+type ValidationErrors []*ValidationError
+
+// Error returns all validation failures in a single message
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("invalid request: %s", strings.Join(messages, "; "))
+}
+
+// Unwrap returns the individual validation errors
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// Is reports whether the target is ErrInvalidRequest
+func (e ValidationErrors) Is(target error) bool {
+	return target == ErrInvalidRequest
+}
+
+// Fields returns the field paths that failed validation
+func (e ValidationErrors) Fields() []string {
+	fields := make([]string, 0, len(e))
+	for _, err := range e {
+		fields = append(fields, err.Field)
+	}
+	return fields
+}
+
+// validator collects validation errors
+type validator struct {
+	errs ValidationErrors
+}
+
+// addf records a validation error for a field
+func (v *validator) addf(field, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// dataType checks a DATA_TYPE value
+func (v *validator) dataType(field, value, want string) {
+	switch value {
+	case want:
+	case "":
+		v.addf(field, "is required and must be %q", want)
+	default:
+		v.addf(field, "must be %q, got %q", want, value)
+	}
+}
+
+// endpointID checks that an endpoint ID is present and is a UUID
+func (v *validator) endpointID(field, value string) {
+	if value == "" {
+		v.addf(field, "is required")
+		return
+	}
+	if !isUUID(value) {
+		v.addf(field, "must be a UUID, got %q", value)
+	}
+}
+
+// absolutePath checks that a path is present and absolute
+func (v *validator) absolutePath(field, value string) {
+	if value == "" {
+		v.addf(field, "is required")
+		return
+	}
+	if !strings.HasPrefix(value, "/") && !strings.HasPrefix(value, "~") {
+		v.addf(field, "must be an absolute path, got %q", value)
+	}
+}
+
+// deadline checks that a deadline is in the future
+func (v *validator) deadline(field string, value *time.Time) {
+	if value != nil && !value.After(time.Now()) {
+		v.addf(field, "must be in the future, got %s", value.Format(time.RFC3339))
+	}
+}
+
+// itemCount checks the number of items in a request and reports whether
+// the individual items should be checked
+func (v *validator) itemCount(count int) bool {
+	if count == 0 {
+		v.addf("DATA", "at least one item is required")
+		return false
+	}
+	if count > MaxItemsPerTask {
+		v.addf("DATA", "has %d items, the maximum per task is %d", count, MaxItemsPerTask)
+		return false
+	}
+	return true
+}
+
+// err returns the collected errors, or nil if there are none
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// isUUID reports whether s is a UUID in canonical 8-4-4-4-12 form
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	_, err := uuid.Parse(s)
+	return err == nil
+}
+
+// Validate checks the request for problems the Transfer API would reject.
+// It returns ValidationErrors listing every invalid field, or nil.
+func (r *TransferTaskRequest) Validate() error {
+	v := &validator{}
+
+	v.dataType("DATA_TYPE", r.DataType, "transfer")
+	v.endpointID("source_endpoint", r.SourceEndpointID)
+	v.endpointID("destination_endpoint", r.DestinationEndpointID)
+	v.deadline("deadline", r.Deadline)
+
+	if r.SyncLevel < SyncLevelExists || r.SyncLevel > SyncLevelChecksum {
+		v.addf("sync_level", "must be between %d and %d, got %d", SyncLevelExists, SyncLevelChecksum, r.SyncLevel)
+	}
+	if r.SymlinkDepth < 0 {
+		v.addf("symlink_depth", "must not be negative, got %d", r.SymlinkDepth)
+	}
+
+	if !v.itemCount(len(r.Items)) {
+		return v.err()
+	}
+	for i, item := range r.Items {
+		prefix := fmt.Sprintf("DATA[%d].", i)
+		v.dataType(prefix+"DATA_TYPE", item.DataType, "transfer_item")
+		v.absolutePath(prefix+"source_path", item.SourcePath)
+		v.absolutePath(prefix+"destination_path", item.DestinationPath)
+	}
+
+	return v.err()
+}
+
+// Validate checks the request for problems the Transfer API would reject.
+// It returns ValidationErrors listing every invalid field, or nil.
+func (r *DeleteTaskRequest) Validate() error {
+	v := &validator{}
+
+	v.dataType("DATA_TYPE", r.DataType, "delete")
+	v.endpointID("endpoint", r.EndpointID)
+	v.deadline("deadline", r.Deadline)
+
+	if !v.itemCount(len(r.Items)) {
+		return v.err()
+	}
+	for i, item := range r.Items {
+		prefix := fmt.Sprintf("DATA[%d].", i)
+		v.dataType(prefix+"DATA_TYPE", item.DataType, "delete_item")
+		v.absolutePath(prefix+"path", item.Path)
+	}
+
+	return v.err()
+}
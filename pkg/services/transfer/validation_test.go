@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package transfer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Endpoint IDs used by tests that submit tasks, which must be UUIDs
+const (
+	testSourceEndpointID      = "6f1e2a3b-0000-4000-8000-00000000a001"
+	testDestinationEndpointID = "6f1e2a3b-0000-4000-8000-00000000b002"
+	testOtherEndpointID       = "6f1e2a3b-0000-4000-8000-00000000c003"
+)
+
+func TestTransferTaskRequestValidate(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	valid := func() *TransferTaskRequest {
+		return &TransferTaskRequest{
+			DataType:              "transfer",
+			SourceEndpointID:      testSourceEndpointID,
+			DestinationEndpointID: testDestinationEndpointID,
+			Deadline:              &future,
+			Items: []TransferItem{
+				{DataType: "transfer_item", SourcePath: "/data/a", DestinationPath: "~/a"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(r *TransferTaskRequest)
+		fields []string
+	}{
+		{
+			name:   "valid",
+			modify: func(r *TransferTaskRequest) {},
+		},
+		{
+			name:   "missing data type",
+			modify: func(r *TransferTaskRequest) { r.DataType = "" },
+			fields: []string{"DATA_TYPE"},
+		},
+		{
+			name: "bad endpoint IDs",
+			modify: func(r *TransferTaskRequest) {
+				r.SourceEndpointID = "not-a-uuid"
+				r.DestinationEndpointID = ""
+			},
+			fields: []string{"source_endpoint", "destination_endpoint"},
+		},
+		{
+			name: "relative paths and wrong item type",
+			modify: func(r *TransferTaskRequest) {
+				r.Items = append(r.Items, TransferItem{DataType: "delete_item", SourcePath: "rel/a", DestinationPath: "/b"})
+			},
+			fields: []string{"DATA[1].DATA_TYPE", "DATA[1].source_path"},
+		},
+		{
+			name:   "empty items",
+			modify: func(r *TransferTaskRequest) { r.Items = nil },
+			fields: []string{"DATA"},
+		},
+		{
+			name:   "too many items",
+			modify: func(r *TransferTaskRequest) { r.Items = make([]TransferItem, MaxItemsPerTask+1) },
+			fields: []string{"DATA"},
+		},
+		{
+			name: "past deadline and sync level",
+			modify: func(r *TransferTaskRequest) {
+				r.Deadline = &past
+				r.SyncLevel = 4
+			},
+			fields: []string{"deadline", "sync_level"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := valid()
+			tt.modify(request)
+			err := request.Validate()
+
+			if len(tt.fields) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var validationErrs ValidationErrors
+			if !errors.As(err, &validationErrs) {
+				t.Fatalf("Validate() error = %v, want ValidationErrors", err)
+			}
+			if !errors.Is(err, ErrInvalidRequest) {
+				t.Error("errors.Is(err, ErrInvalidRequest) = false, want true")
+			}
+			if got := validationErrs.Fields(); !reflect.DeepEqual(got, tt.fields) {
+				t.Errorf("Validate() fields = %v, want %v", got, tt.fields)
+			}
+		})
+	}
+}
+
+func TestDeleteTaskRequestValidate(t *testing.T) {
+	request := &DeleteTaskRequest{
+		DataType:   "delete",
+		EndpointID: testSourceEndpointID,
+		Items:      []DeleteItem{{DataType: "delete_item", Path: "/scratch/old"}},
+	}
+	if err := request.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want nil", err)
+	}
+
+	request.EndpointID = "endpoint"
+	request.Items = append(request.Items, DeleteItem{Path: "relative"})
+
+	var validationErrs ValidationErrors
+	if !errors.As(request.Validate(), &validationErrs) {
+		t.Fatal("Validate() did not return ValidationErrors")
+	}
+	want := []string{"endpoint", "DATA[1].DATA_TYPE", "DATA[1].path"}
+	if got := validationErrs.Fields(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate() fields = %v, want %v", got, want)
+	}
+}
+
+func TestSubmitTransferValidatesBeforeRequest(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+	}
+
+	server, client := setupMockServer(handler)
+	defer server.Close()
+
+	_, err := client.SubmitTransfer(context.Background(),
+		"source", "relative/path",
+		testDestinationEndpointID, "/dest",
+		"label", nil)
+	if !errors.Is(err, ErrInvalidRequest) {
+		t.Fatalf("SubmitTransfer() error = %v, want ErrInvalidRequest", err)
+	}
+
+	_, err = client.CreateDeleteTask(context.Background(), &DeleteTaskRequest{EndpointID: testSourceEndpointID})
+	if !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("CreateDeleteTask() error = %v, want ErrInvalidRequest", err)
+	}
+}
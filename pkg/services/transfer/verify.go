@@ -0,0 +1,407 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MismatchKind describes how a destination file differs from its source
+type MismatchKind string
+
+// Kinds of verification mismatches
+const (
+	// MismatchMissing means the file exists at the source but not at the destination
+	MismatchMissing MismatchKind = "missing"
+
+	// MismatchExtra means the file exists at the destination but not at the source
+	MismatchExtra MismatchKind = "extra"
+
+	// MismatchType means one side is a file and the other a directory
+	MismatchType MismatchKind = "type"
+
+	// MismatchSize means the file sizes differ
+	MismatchSize MismatchKind = "size"
+
+	// MismatchMtime means the modification times differ by more than the tolerance
+	MismatchMtime MismatchKind = "mtime"
+
+	// MismatchChecksum means both listings reported checksums and they differ
+	MismatchChecksum MismatchKind = "checksum"
+)
+
+// VerifyOptions contains options for verifying a transfer
+type VerifyOptions struct {
+	// SourceEndpointID and DestinationEndpointID identify the endpoints.
+	// They are taken from the task when verifying a task.
+	SourceEndpointID      string
+	DestinationEndpointID string
+
+	// SourcePath and DestinationPath are the roots to compare recursively.
+	// They are not used when verifying a task.
+	SourcePath      string
+	DestinationPath string
+
+	// CompareMtime compares modification times in addition to sizes
+	CompareMtime bool
+
+	// MtimeTolerance is the largest modification time difference accepted
+	MtimeTolerance time.Duration
+
+	// ReportExtra reports files that only exist at the destination
+	ReportExtra bool
+
+	// ShowHidden includes hidden files in the listings
+	ShowHidden bool
+}
+
+// DefaultVerifyOptions returns default options for verifying a transfer
+func DefaultVerifyOptions() *VerifyOptions {
+	return &VerifyOptions{
+		CompareMtime:   true,
+		MtimeTolerance: 2 * time.Second,
+		ReportExtra:    true,
+		ShowHidden:     true,
+	}
+}
+
+// VerificationMismatch is a single difference between source and destination
+type VerificationMismatch struct {
+	Kind            MismatchKind `json:"kind"`
+	SourcePath      string       `json:"source_path,omitempty"`
+	DestinationPath string       `json:"destination_path"`
+	SourceValue     string       `json:"source_value,omitempty"`
+	DestValue       string       `json:"destination_value,omitempty"`
+}
+
+// VerificationReport is the result of comparing source and destination listings
+type VerificationReport struct {
+	TaskID                string                 `json:"task_id,omitempty"`
+	SourceEndpointID      string                 `json:"source_endpoint_id"`
+	DestinationEndpointID string                 `json:"destination_endpoint_id"`
+	SourcePath            string                 `json:"source_path,omitempty"`
+	DestinationPath       string                 `json:"destination_path,omitempty"`
+	SourceFiles           int                    `json:"source_files"`
+	SourceBytes           int64                  `json:"source_bytes"`
+	DestinationFiles      int                    `json:"destination_files"`
+	DestinationBytes      int64                  `json:"destination_bytes"`
+	ChecksumsCompared     int                    `json:"checksums_compared"`
+	Mismatches            []VerificationMismatch `json:"mismatches"`
+	VerifiedAt            time.Time              `json:"verified_at"`
+}
+
+// OK returns true if no mismatches were found
+func (r *VerificationReport) OK() bool {
+	return len(r.Mismatches) == 0
+}
+
+// CorrectiveTransferRequest builds a transfer request that re-sends every file
+// that is missing or differs at the destination. Extra destination files are
+// not included. It returns nil if there is nothing to correct.
+func (r *VerificationReport) CorrectiveTransferRequest(label string) *TransferTaskRequest {
+	var items []TransferItem
+	seen := make(map[string]bool)
+	for _, mismatch := range r.Mismatches {
+		if mismatch.Kind == MismatchExtra || mismatch.SourcePath == "" || seen[mismatch.SourcePath] {
+			continue
+		}
+		seen[mismatch.SourcePath] = true
+		items = append(items, TransferItem{
+			DataType:        "transfer_item",
+			SourcePath:      mismatch.SourcePath,
+			DestinationPath: mismatch.DestinationPath,
+		})
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	return &TransferTaskRequest{
+		DataType:              "transfer",
+		Label:                 label,
+		SourceEndpointID:      r.SourceEndpointID,
+		DestinationEndpointID: r.DestinationEndpointID,
+		VerifyChecksum:        true,
+		PreserveMtime:         true,
+		Items:                 items,
+	}
+}
+
+// VerifyTransfer recursively lists the source and destination paths and
+// compares file counts, sizes, modification times and, where both listings
+// provide them, checksums.
+func (c *Client) VerifyTransfer(ctx context.Context, options *VerifyOptions) (*VerificationReport, error) {
+	if options == nil {
+		return nil, fmt.Errorf("verify options are required")
+	}
+	if options.SourceEndpointID == "" || options.DestinationEndpointID == "" {
+		return nil, fmt.Errorf("source and destination endpoints are required")
+	}
+	if options.SourcePath == "" || options.DestinationPath == "" {
+		return nil, fmt.Errorf("source and destination paths are required")
+	}
+
+	sourceFiles, err := c.walkFiles(ctx, options.SourceEndpointID, options.SourcePath, options.ShowHidden)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list source: %w", err)
+	}
+	destFiles, err := c.walkFiles(ctx, options.DestinationEndpointID, options.DestinationPath, options.ShowHidden)
+	if err != nil && !IsResourceNotFound(err) {
+		return nil, fmt.Errorf("failed to list destination: %w", err)
+	}
+
+	report := &VerificationReport{
+		SourceEndpointID:      options.SourceEndpointID,
+		DestinationEndpointID: options.DestinationEndpointID,
+		SourcePath:            options.SourcePath,
+		DestinationPath:       options.DestinationPath,
+		Mismatches:            []VerificationMismatch{},
+		VerifiedAt:            time.Now(),
+	}
+
+	for _, relPath := range sortedKeys(sourceFiles) {
+		report.compare(
+			path.Join(options.SourcePath, relPath), sourceFiles[relPath],
+			path.Join(options.DestinationPath, relPath), destFiles[relPath],
+			options,
+		)
+	}
+
+	for _, relPath := range sortedKeys(destFiles) {
+		if _, ok := sourceFiles[relPath]; ok {
+			continue
+		}
+		report.countDestination(destFiles[relPath])
+		if options.ReportExtra {
+			report.Mismatches = append(report.Mismatches, VerificationMismatch{
+				Kind:            MismatchExtra,
+				DestinationPath: path.Join(options.DestinationPath, relPath),
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// VerifyTransferTask compares every file a task reported as successfully
+// transferred against the destination. Each parent directory is listed once
+// on each side.
+func (c *Client) VerifyTransferTask(ctx context.Context, taskID string, options *VerifyOptions) (*VerificationReport, error) {
+	if options == nil {
+		options = DefaultVerifyOptions()
+	}
+
+	task, err := c.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	report := &VerificationReport{
+		TaskID:                taskID,
+		SourceEndpointID:      task.SourceEndpointID,
+		DestinationEndpointID: task.DestinationEndpointID,
+		Mismatches:            []VerificationMismatch{},
+		VerifiedAt:            time.Now(),
+	}
+
+	sourceDirs := make(map[string]map[string]FileListItem)
+	destDirs := make(map[string]map[string]FileListItem)
+
+	transfers := c.AllSuccessfulTransfers(ctx, taskID)
+	for transfers.Next() {
+		transferred := transfers.Value()
+		source, err := c.lookupFile(ctx, sourceDirs, task.SourceEndpointID, transferred.SourcePath, options.ShowHidden)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list source: %w", err)
+		}
+		dest, err := c.lookupFile(ctx, destDirs, task.DestinationEndpointID, transferred.DestinationPath, options.ShowHidden)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list destination: %w", err)
+		}
+
+		if source == nil {
+			// The source changed after the transfer; nothing to compare against
+			if dest != nil {
+				report.countDestination(dest)
+			}
+			continue
+		}
+		report.compare(transferred.SourcePath, source, transferred.DestinationPath, dest, options)
+	}
+	if err := transfers.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list successful transfers: %w", err)
+	}
+
+	return report, nil
+}
+
+// compare records the source and destination entries and any mismatches
+func (r *VerificationReport) compare(sourcePath string, source *FileListItem, destPath string, dest *FileListItem, options *VerifyOptions) {
+	r.SourceFiles++
+	r.SourceBytes += source.Size
+
+	mismatch := VerificationMismatch{SourcePath: sourcePath, DestinationPath: destPath}
+	if dest == nil {
+		mismatch.Kind = MismatchMissing
+		r.Mismatches = append(r.Mismatches, mismatch)
+		return
+	}
+	r.countDestination(dest)
+
+	switch {
+	case source.Type != dest.Type:
+		mismatch.Kind = MismatchType
+		mismatch.SourceValue, mismatch.DestValue = source.Type, dest.Type
+	case source.Size != dest.Size:
+		mismatch.Kind = MismatchSize
+		mismatch.SourceValue, mismatch.DestValue = fmt.Sprint(source.Size), fmt.Sprint(dest.Size)
+	case source.Checksum != "" && dest.Checksum != "" && !strings.EqualFold(source.Checksum, dest.Checksum):
+		r.ChecksumsCompared++
+		mismatch.Kind = MismatchChecksum
+		mismatch.SourceValue, mismatch.DestValue = source.Checksum, dest.Checksum
+	case options.CompareMtime && !mtimesMatch(source.LastModified, dest.LastModified, options.MtimeTolerance):
+		mismatch.Kind = MismatchMtime
+		mismatch.SourceValue, mismatch.DestValue = source.LastModified, dest.LastModified
+	default:
+		if source.Checksum != "" && dest.Checksum != "" {
+			r.ChecksumsCompared++
+		}
+		return
+	}
+
+	r.Mismatches = append(r.Mismatches, mismatch)
+}
+
+// countDestination adds a destination entry to the report totals
+func (r *VerificationReport) countDestination(dest *FileListItem) {
+	r.DestinationFiles++
+	r.DestinationBytes += dest.Size
+}
+
+// walkFiles lists all files below root, keyed by their path relative to root
+func (c *Client) walkFiles(ctx context.Context, endpointID, root string, showHidden bool) (map[string]*FileListItem, error) {
+	files := make(map[string]*FileListItem)
+	dirs := []string{""}
+
+	for len(dirs) > 0 {
+		relDir := dirs[0]
+		dirs = dirs[1:]
+
+		entries, err := c.listAll(ctx, endpointID, path.Join(root, relDir), showHidden)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range entries {
+			entry := &entries[i]
+			relPath := path.Join(relDir, entry.Name)
+			if entry.Type == "dir" {
+				dirs = append(dirs, relPath)
+				continue
+			}
+			files[relPath] = entry
+		}
+	}
+
+	return files, nil
+}
+
+// lookupFile returns the listing entry for filePath, listing and caching its
+// parent directory on first use. A missing file or directory returns nil.
+func (c *Client) lookupFile(
+	ctx context.Context,
+	cache map[string]map[string]FileListItem,
+	endpointID, filePath string,
+	showHidden bool,
+) (*FileListItem, error) {
+	dir, name := path.Split(filePath)
+	entries, ok := cache[dir]
+	if !ok {
+		listing, err := c.listAll(ctx, endpointID, dir, showHidden)
+		if err != nil && !IsResourceNotFound(err) {
+			return nil, err
+		}
+		entries = make(map[string]FileListItem, len(listing))
+		for _, entry := range listing {
+			entries[entry.Name] = entry
+		}
+		cache[dir] = entries
+	}
+
+	entry, ok := entries[name]
+	if !ok {
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+// listAll lists a directory, following pagination markers
+func (c *Client) listAll(ctx context.Context, endpointID, dir string, showHidden bool) ([]FileListItem, error) {
+	var entries []FileListItem
+	options := &ListFileOptions{ShowHidden: showHidden}
+
+	for {
+		listing, err := c.ListFiles(ctx, endpointID, dir, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list directory %s: %w", dir, err)
+		}
+		entries = append(entries, listing.Data...)
+
+		if !listing.HasNextPage || listing.Marker == "" || listing.Marker == options.Marker {
+			return entries, nil
+		}
+		options.Marker = listing.Marker
+	}
+}
+
+// mtimesMatch compares two listing timestamps within a tolerance.
+// Timestamps that cannot be parsed are compared as strings.
+func mtimesMatch(a, b string, tolerance time.Duration) bool {
+	if a == b {
+		return true
+	}
+	ta, errA := parseListingTime(a)
+	tb, errB := parseListingTime(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	diff := ta.Sub(tb)
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= tolerance
+}
+
+// listingTimeFormats are the timestamp formats used by Transfer listings
+var listingTimeFormats = []string{
+	"2006-01-02 15:04:05-07:00",
+	"2006-01-02 15:04:05Z07:00",
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+}
+
+// parseListingTime parses a last_modified value from a listing
+func parseListingTime(value string) (time.Time, error) {
+	for _, format := range listingTimeFormats {
+		if t, err := time.Parse(format, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized timestamp %q", value)
+}
+
+// sortedKeys returns the keys of a file map in sorted order
+func sortedKeys(files map[string]*FileListItem) []string {
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
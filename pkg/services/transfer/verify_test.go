@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// mockListingHandler serves directory listings from a map of
+// endpoint ID -> directory path -> entries
+func mockListingHandler(t *testing.T, listings map[string]map[string][]FileListItem, extra http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(parts) == 4 && parts[0] == "operation" && parts[3] == "ls" {
+			dir := strings.TrimSuffix(r.URL.Query().Get("path"), "/")
+			entries, ok := listings[parts[2]][dir]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				json.NewEncoder(w).Encode(map[string]string{
+					"code":    "ClientError.NotFound",
+					"message": "Directory not found",
+				})
+				return
+			}
+			json.NewEncoder(w).Encode(FileList{Data: entries, Path: dir})
+			return
+		}
+		if extra != nil {
+			extra(w, r)
+			return
+		}
+		t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestVerifyTransfer(t *testing.T) {
+	listings := map[string]map[string][]FileListItem{
+		"src": {
+			"/data": {
+				{Name: "same.txt", Type: "file", Size: 10, LastModified: "2025-01-01 10:00:00+00:00"},
+				{Name: "resized.txt", Type: "file", Size: 20, LastModified: "2025-01-01 10:00:00+00:00"},
+				{Name: "sub", Type: "dir"},
+			},
+			"/data/sub": {
+				{Name: "missing.txt", Type: "file", Size: 5, LastModified: "2025-01-01 10:00:00+00:00"},
+				{Name: "touched.txt", Type: "file", Size: 7, LastModified: "2025-01-01 10:00:00+00:00"},
+				{Name: "sum.txt", Type: "file", Size: 8, Checksum: "abc"},
+			},
+		},
+		"dst": {
+			"/copy": {
+				{Name: "same.txt", Type: "file", Size: 10, LastModified: "2025-01-01 10:00:01+00:00"},
+				{Name: "resized.txt", Type: "file", Size: 19, LastModified: "2025-01-01 10:00:00+00:00"},
+				{Name: "extra.txt", Type: "file", Size: 1},
+				{Name: "sub", Type: "dir"},
+			},
+			"/copy/sub": {
+				{Name: "touched.txt", Type: "file", Size: 7, LastModified: "2025-02-01 10:00:00+00:00"},
+				{Name: "sum.txt", Type: "file", Size: 8, Checksum: "def"},
+			},
+		},
+	}
+
+	server, client := setupMockServer(mockListingHandler(t, listings, nil))
+	defer server.Close()
+
+	options := DefaultVerifyOptions()
+	options.SourceEndpointID, options.SourcePath = "src", "/data"
+	options.DestinationEndpointID, options.DestinationPath = "dst", "/copy"
+
+	report, err := client.VerifyTransfer(context.Background(), options)
+	if err != nil {
+		t.Fatalf("VerifyTransfer() error = %v", err)
+	}
+
+	if report.OK() {
+		t.Fatal("VerifyTransfer() report OK, want mismatches")
+	}
+	if report.SourceFiles != 5 || report.DestinationFiles != 5 {
+		t.Errorf("File counts = %d/%d, want 5/5", report.SourceFiles, report.DestinationFiles)
+	}
+	if report.SourceBytes != 50 {
+		t.Errorf("SourceBytes = %d, want 50", report.SourceBytes)
+	}
+
+	got := make(map[string]MismatchKind)
+	for _, m := range report.Mismatches {
+		got[m.DestinationPath] = m.Kind
+	}
+	want := map[string]MismatchKind{
+		"/copy/resized.txt":     MismatchSize,
+		"/copy/sub/missing.txt": MismatchMissing,
+		"/copy/sub/touched.txt": MismatchMtime,
+		"/copy/sub/sum.txt":     MismatchChecksum,
+		"/copy/extra.txt":       MismatchExtra,
+	}
+	if len(got) != len(want) {
+		t.Errorf("Mismatches = %v, want %v", got, want)
+	}
+	for p, kind := range want {
+		if got[p] != kind {
+			t.Errorf("Mismatch for %s = %q, want %q", p, got[p], kind)
+		}
+	}
+
+	corrective := report.CorrectiveTransferRequest("fix")
+	if corrective == nil {
+		t.Fatal("CorrectiveTransferRequest() = nil")
+	}
+	if corrective.SourceEndpointID != "src" || corrective.DestinationEndpointID != "dst" {
+		t.Errorf("Corrective endpoints = %s -> %s", corrective.SourceEndpointID, corrective.DestinationEndpointID)
+	}
+	if len(corrective.Items) != 4 {
+		t.Errorf("Corrective items = %d, want 4 (extra files excluded)", len(corrective.Items))
+	}
+}
+
+func TestVerifyTransferMissingDestination(t *testing.T) {
+	listings := map[string]map[string][]FileListItem{
+		"src": {"/data": {{Name: "a.txt", Type: "file", Size: 1}}},
+	}
+
+	server, client := setupMockServer(mockListingHandler(t, listings, nil))
+	defer server.Close()
+
+	report, err := client.VerifyTransfer(context.Background(), &VerifyOptions{
+		SourceEndpointID: "src", SourcePath: "/data",
+		DestinationEndpointID: "dst", DestinationPath: "/nowhere",
+	})
+	if err != nil {
+		t.Fatalf("VerifyTransfer() error = %v", err)
+	}
+	if len(report.Mismatches) != 1 || report.Mismatches[0].Kind != MismatchMissing {
+		t.Errorf("Mismatches = %+v, want one missing file", report.Mismatches)
+	}
+
+	if _, err := client.VerifyTransfer(context.Background(), &VerifyOptions{SourceEndpointID: "src"}); err == nil {
+		t.Error("VerifyTransfer() with incomplete options should return error")
+	}
+}
+
+func TestVerifyTransferTask(t *testing.T) {
+	listings := map[string]map[string][]FileListItem{
+		"src": {"/in": {
+			{Name: "a.txt", Type: "file", Size: 3},
+			{Name: "b.txt", Type: "file", Size: 4},
+		}},
+		"dst": {"/out": {
+			{Name: "a.txt", Type: "file", Size: 3},
+			{Name: "b.txt", Type: "file", Size: 2},
+		}},
+	}
+
+	taskAPI := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/task/task-1":
+			json.NewEncoder(w).Encode(Task{TaskID: "task-1", SourceEndpointID: "src", DestinationEndpointID: "dst"})
+		case "/task/task-1/successful_transfers":
+			if r.URL.Query().Get("marker") == "" {
+				json.NewEncoder(w).Encode(SuccessfulTransferList{
+					Data:       []SuccessfulTransfer{{SourcePath: "/in/a.txt", DestinationPath: "/out/a.txt"}},
+					NextMarker: "page-2",
+				})
+				return
+			}
+			json.NewEncoder(w).Encode(SuccessfulTransferList{
+				Data: []SuccessfulTransfer{{SourcePath: "/in/b.txt", DestinationPath: "/out/b.txt"}},
+			})
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}
+
+	server, client := setupMockServer(mockListingHandler(t, listings, taskAPI))
+	defer server.Close()
+
+	report, err := client.VerifyTransferTask(context.Background(), "task-1", nil)
+	if err != nil {
+		t.Fatalf("VerifyTransferTask() error = %v", err)
+	}
+
+	if report.SourceFiles != 2 || report.DestinationFiles != 2 {
+		t.Errorf("File counts = %d/%d, want 2/2", report.SourceFiles, report.DestinationFiles)
+	}
+	if len(report.Mismatches) != 1 || report.Mismatches[0].Kind != MismatchSize ||
+		report.Mismatches[0].SourcePath != "/in/b.txt" {
+		t.Errorf("Mismatches = %+v, want size mismatch for /in/b.txt", report.Mismatches)
+	}
+}
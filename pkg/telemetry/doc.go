@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2025 Scott Friedman and Project Contributors
+
+/*
+Package telemetry instruments Globus Go SDK clients with OpenTelemetry.
+
+# STABILITY: BETA
+
+This package is approaching stability but may still undergo minor changes.
+The middleware, its options and the span and metric names are considered
+relatively stable; the attributes recorded may grow as the OpenTelemetry
+semantic conventions evolve.
+
+It is a separate Go module, github.com/scttfrdmn/globus-go-sdk/pkg/telemetry,
+so that applications which do not use OpenTelemetry do not depend on it.
+
+# Tracing
+
+Middleware starts a client span for every request made through a
+core.Client. Spans are named after the SDK operation that made the request,
+such as transfer.CreateTransferTask, and record the HTTP method, server,
+status code, the Globus request ID and error code of failed requests, and
+the retries made by core.RetryMiddleware. The W3C trace context of the span
+is propagated to the service in the request headers.
+
+# Metrics
+
+Middleware records the duration of every request in the
+http.client.request.duration histogram and counts failed requests in
+globus.client.request.errors, both with the operation, method, server and
+status code as attributes.
+
+# Basic Usage
+
+	config := pkg.NewConfigFromEnvironment().
+		WithClientOption(telemetry.ClientOption(
+			telemetry.WithTracerProvider(tracerProvider),
+			telemetry.WithMeterProvider(meterProvider),
+		))
+
+Add the telemetry middleware before core.RetryMiddleware so that a request
+and its retries share one span. Without options, the global providers and
+propagator registered with the otel package are used.
+*/
+package telemetry
@@ -0,0 +1,19 @@
+module github.com/scttfrdmn/globus-go-sdk/pkg/telemetry
+
+go 1.21
+
+require (
+	github.com/scttfrdmn/globus-go-sdk v0.0.0-20261018144601-f0ebf5518aed
+	go.opentelemetry.io/otel v1.29.0
+	go.opentelemetry.io/otel/metric v1.29.0
+	go.opentelemetry.io/otel/sdk v1.29.0
+	go.opentelemetry.io/otel/sdk/metric v1.29.0
+	go.opentelemetry.io/otel/trace v1.29.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	golang.org/x/sys v0.28.0 // indirect
+)
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"runtime"
+	"strings"
+	"unicode"
+)
+
+// servicesPackage is the import path prefix of the service packages
+const servicesPackage = "github.com/scttfrdmn/globus-go-sdk/pkg/services/"
+
+// operationKey is the context key for WithOperation
+type operationKey struct{}
+
+// WithOperation returns a context whose requests are reported under the given
+// operation name instead of the one found from the call stack
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// operationName returns the operation set with WithOperation or otherwise
+// the innermost exported service method on the call stack, such as
+// transfer.CreateTransferTask. It returns "" if neither is found.
+func operationName(req *http.Request) string {
+	if operation, ok := req.Context().Value(operationKey{}).(string); ok && operation != "" {
+		return operation
+	}
+
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if operation, ok := serviceMethod(frame.Function); ok {
+			return operation
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// serviceMethod turns a function name such as
+// github.com/scttfrdmn/globus-go-sdk/pkg/services/transfer.(*Client).GetTask
+// into transfer.GetTask. Functions outside the service packages, plain
+// functions, closures and unexported methods are not operations.
+func serviceMethod(function string) (string, bool) {
+	rest, ok := strings.CutPrefix(function, servicesPackage)
+	if !ok {
+		return "", false
+	}
+	pkg, symbol, ok := strings.Cut(rest, ".")
+	if !ok || !strings.HasPrefix(symbol, "(") {
+		return "", false
+	}
+	_, method, ok := strings.Cut(symbol, ").")
+	if !ok || method == "" || strings.Contains(method, ".") || !unicode.IsUpper(rune(method[0])) {
+		return "", false
+	}
+	return path.Base(pkg) + "." + method, true
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+)
+
+// instrumentationName identifies the SDK to tracer and meter providers
+const instrumentationName = "github.com/scttfrdmn/globus-go-sdk/pkg/telemetry"
+
+// Attribute keys recorded in addition to the OpenTelemetry HTTP conventions
+const (
+	// OperationKey is the SDK operation that made the request
+	OperationKey = attribute.Key("globus.operation")
+
+	// RequestIDKey is the request ID the Globus service assigned
+	RequestIDKey = attribute.Key("globus.request_id")
+
+	// ErrorCodeKey is the Globus error code of a failed request
+	ErrorCodeKey = attribute.Key("globus.error_code")
+)
+
+// config holds the providers used by the middleware
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// Option configures the telemetry middleware
+type Option func(*config)
+
+// WithTracerProvider sets the tracer provider used to create spans
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the meter provider used to record metrics
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// WithPropagator sets the propagator that writes the trace context into
+// request headers
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = propagator
+	}
+}
+
+// ClientOption returns a core client option that adds the telemetry
+// middleware, for use with SDKConfig.WithClientOption or a service's core
+// options
+func ClientOption(options ...Option) core.ClientOption {
+	return core.WithMiddleware(Middleware(options...))
+}
+
+// Middleware returns core middleware that traces each request and records
+// its duration and errors. Options default to the global providers and
+// propagator registered with the otel package.
+func Middleware(options ...Option) core.Middleware {
+	cfg := &config{}
+	for _, option := range options {
+		option(cfg)
+	}
+	if cfg.tracerProvider == nil {
+		cfg.tracerProvider = otel.GetTracerProvider()
+	}
+	if cfg.meterProvider == nil {
+		cfg.meterProvider = otel.GetMeterProvider()
+	}
+	if cfg.propagator == nil {
+		cfg.propagator = otel.GetTextMapPropagator()
+	}
+
+	tracer := cfg.tracerProvider.Tracer(instrumentationName, trace.WithInstrumentationVersion(core.Version))
+	meter := cfg.meterProvider.Meter(instrumentationName, metric.WithInstrumentationVersion(core.Version))
+
+	duration, err := meter.Float64Histogram("http.client.request.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of Globus API requests"))
+	if err != nil {
+		otel.Handle(err)
+		duration = noop.Float64Histogram{}
+	}
+	failures, err := meter.Int64Counter("globus.client.request.errors",
+		metric.WithUnit("{request}"),
+		metric.WithDescription("Globus API requests that failed or returned an error status"))
+	if err != nil {
+		otel.Handle(err)
+		failures = noop.Int64Counter{}
+	}
+
+	return func(next core.RoundTripperFunc) core.RoundTripperFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			operation := operationName(req)
+			attrs := []attribute.KeyValue{
+				semconv.HTTPRequestMethodKey.String(req.Method),
+				semconv.ServerAddress(req.URL.Hostname()),
+			}
+			if operation != "" {
+				attrs = append(attrs, OperationKey.String(operation))
+			} else {
+				operation = req.Method
+			}
+
+			ctx, span := tracer.Start(req.Context(), operation,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+				trace.WithAttributes(semconv.URLFull(redactedURL(req))))
+			defer span.End()
+
+			retries := 0
+			ctx = core.WithRetryObserver(ctx, func(retry int) {
+				retries = retry
+				span.AddEvent("retry", trace.WithAttributes(semconv.HTTPRequestResendCount(retry)))
+			})
+			req = req.Clone(ctx)
+			cfg.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+			start := time.Now()
+			resp, err := next(req)
+			elapsed := time.Since(start)
+
+			if retries > 0 {
+				span.SetAttributes(semconv.HTTPRequestResendCount(retries))
+			}
+			failed := true
+			switch {
+			case err != nil:
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				attrs = append(attrs, semconv.ErrorTypeKey.String(errorType(err)))
+			case resp.StatusCode >= 400:
+				status := semconv.HTTPResponseStatusCode(resp.StatusCode)
+				span.SetAttributes(status)
+				attrs = append(attrs, status, semconv.ErrorTypeKey.String(strconv.Itoa(resp.StatusCode)))
+
+				description := http.StatusText(resp.StatusCode)
+				if apiErr := peekError(resp); apiErr != nil {
+					if id := apiErr.ErrorRequestID(); id != "" {
+						span.SetAttributes(RequestIDKey.String(id))
+					}
+					if code := apiErr.ErrorCode(); code != "" {
+						span.SetAttributes(ErrorCodeKey.String(code))
+					}
+					description = apiErr.Error()
+				}
+				span.SetStatus(codes.Error, description)
+			default:
+				failed = false
+				status := semconv.HTTPResponseStatusCode(resp.StatusCode)
+				span.SetAttributes(status)
+				attrs = append(attrs, status)
+				if id := resp.Header.Get("X-Request-Id"); id != "" {
+					span.SetAttributes(RequestIDKey.String(id))
+				}
+			}
+
+			set := metric.WithAttributes(attrs...)
+			duration.Record(ctx, elapsed.Seconds(), set)
+			if failed {
+				failures.Add(ctx, 1, set)
+			}
+			return resp, err
+		}
+	}
+}
+
+// peekError decodes the error body of a response and restores the body for
+// the caller. It returns nil if the body cannot be read.
+func peekError(resp *http.Response) core.APIError {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+
+	peeked := *resp
+	peeked.Body = io.NopCloser(bytes.NewReader(body))
+	var apiErr core.APIError
+	if !errors.As(core.NewAPIError(&peeked), &apiErr) {
+		return nil
+	}
+	return apiErr
+}
+
+// redactedURL returns the request URL without user information or query,
+// which can carry credentials
+func redactedURL(req *http.Request) string {
+	u := *req.URL
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
+
+// errorType describes a transport error for the error.type attribute
+func errorType(err error) string {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	}
+	return fmt.Sprintf("%T", err)
+}
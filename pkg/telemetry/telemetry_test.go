@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2025 Scott Friedman and Project Contributors
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/core/ratelimit"
+	"github.com/scttfrdmn/globus-go-sdk/pkg/services/transfer"
+)
+
+// staticAuthorizer authorizes requests with a fixed token
+type staticAuthorizer string
+
+func (a staticAuthorizer) GetAuthorizationHeader(ctx ...context.Context) (string, error) {
+	return "Bearer " + string(a), nil
+}
+
+// setup returns a Transfer client for server instrumented with in-memory
+// exporters, and retrying failed requests once
+func setup(t *testing.T, server *httptest.Server) (*transfer.Client, *tracetest.InMemoryExporter, *sdkmetric.ManualReader) {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	backoff := ratelimit.NewExponentialBackoff(time.Millisecond, time.Millisecond, 1, 1)
+	client, err := transfer.NewClient(
+		transfer.WithAuthorizer(staticAuthorizer("token")),
+		transfer.WithCoreOption(core.WithBaseURL(server.URL+"/")),
+		transfer.WithCoreOption(ClientOption(
+			WithTracerProvider(tracerProvider),
+			WithMeterProvider(meterProvider),
+			WithPropagator(propagation.TraceContext{}),
+		)),
+		transfer.WithCoreOption(core.WithMiddleware(core.RetryMiddleware(backoff))),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client, exporter, reader
+}
+
+// attributes returns the attributes of a span as a map
+func attributes(kvs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value, len(kvs))
+	for _, kv := range kvs {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+func TestMiddlewareTracesOperations(t *testing.T) {
+	var traceparents []string
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/task/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"TaskNotFound","message":"No such task","request_id":"req-404"}`))
+			return
+		}
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(transfer.Task{TaskID: "task-1"})
+	}))
+	defer server.Close()
+
+	client, exporter, _ := setup(t, server)
+	ctx := context.Background()
+
+	if _, err := client.GetTask(ctx, "task-1"); err != nil {
+		t.Fatalf("GetTask() error = %v", err)
+	}
+	if _, err := client.GetTask(WithOperation(ctx, "custom.Lookup"), "missing"); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("GetTask(missing) error = %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Recorded %d spans, want 2", len(spans))
+	}
+
+	// A request and its retry share one span named after the SDK method
+	span := spans[0]
+	attrs := attributes(span.Attributes)
+	if span.Name != "transfer.GetTask" || attrs[OperationKey].AsString() != "transfer.GetTask" {
+		t.Errorf("Span name = %q, operation = %q", span.Name, attrs[OperationKey].AsString())
+	}
+	if attrs["http.request.resend_count"].AsInt64() != 1 || len(span.Events) != 1 {
+		t.Errorf("Retries = %v, events = %d", attrs["http.request.resend_count"], len(span.Events))
+	}
+	if attrs["http.response.status_code"].AsInt64() != http.StatusOK || span.Status.Code == codes.Error {
+		t.Errorf("Status = %v, %v", attrs["http.response.status_code"], span.Status)
+	}
+	traceID := span.SpanContext.TraceID().String()
+	for _, traceparent := range traceparents[:2] {
+		if len(traceparent) < 36 || traceparent[3:35] != traceID {
+			t.Errorf("traceparent = %q, want trace %s", traceparent, traceID)
+		}
+	}
+
+	span = spans[1]
+	attrs = attributes(span.Attributes)
+	if span.Name != "custom.Lookup" {
+		t.Errorf("Span name = %q, want custom.Lookup", span.Name)
+	}
+	if attrs[RequestIDKey].AsString() != "req-404" || attrs[ErrorCodeKey].AsString() != "TaskNotFound" {
+		t.Errorf("Request ID = %q, error code = %q", attrs[RequestIDKey].AsString(), attrs[ErrorCodeKey].AsString())
+	}
+	if span.Status.Code != codes.Error {
+		t.Errorf("Status = %v, want error", span.Status)
+	}
+}
+
+func TestMiddlewareRecordsMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client, _, reader := setup(t, server)
+	if _, err := client.GetTask(context.Background(), "task-1"); err == nil {
+		t.Fatal("GetTask() returned no error")
+	}
+
+	var data metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &data); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	found := map[string]bool{}
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			found[m.Name] = true
+			switch d := m.Data.(type) {
+			case metricdata.Histogram[float64]:
+				if len(d.DataPoints) != 1 || d.DataPoints[0].Count != 1 {
+					t.Errorf("%s data points = %+v", m.Name, d.DataPoints)
+					continue
+				}
+				operation, _ := d.DataPoints[0].Attributes.Value(OperationKey)
+				if operation.AsString() != "transfer.GetTask" {
+					t.Errorf("%s operation = %q", m.Name, operation.AsString())
+				}
+			case metricdata.Sum[int64]:
+				if len(d.DataPoints) != 1 || d.DataPoints[0].Value != 1 {
+					t.Errorf("%s data points = %+v", m.Name, d.DataPoints)
+				}
+			}
+		}
+	}
+	if !found["http.client.request.duration"] || !found["globus.client.request.errors"] {
+		t.Errorf("Metrics = %v", found)
+	}
+}
+
+func TestServiceMethod(t *testing.T) {
+	tests := []struct {
+		function string
+		want     string
+	}{
+		{"github.com/scttfrdmn/globus-go-sdk/pkg/services/transfer.(*Client).GetTask", "transfer.GetTask"},
+		{"github.com/scttfrdmn/globus-go-sdk/pkg/services/flows.(*Client).ListFlows", "flows.ListFlows"},
+		{"github.com/scttfrdmn/globus-go-sdk/pkg/services/transfer.(*Client).doRequest", ""},
+		{"github.com/scttfrdmn/globus-go-sdk/pkg/services/transfer.(*Client).AllTasks.func1", ""},
+		{"github.com/scttfrdmn/globus-go-sdk/pkg/services/transfer.NewClient", ""},
+		{"github.com/scttfrdmn/globus-go-sdk/pkg/core.(*Client).Do", ""},
+	}
+	for _, tt := range tests {
+		if got, _ := serviceMethod(tt.function); got != tt.want {
+			t.Errorf("serviceMethod(%q) = %q, want %q", tt.function, got, tt.want)
+		}
+	}
+}